@@ -0,0 +1,96 @@
+package handler
+
+import (
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	"github.com/arvinpaundra/private-api/config"
+	"github.com/arvinpaundra/private-api/core/format"
+	"github.com/arvinpaundra/private-api/core/storage"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+type AttachmentHandler struct {
+	logger *zap.Logger
+}
+
+func NewAttachmentHandler(logger *zap.Logger) *AttachmentHandler {
+	return &AttachmentHandler{
+		logger: logger.With(zap.String("domain", "attachment")),
+	}
+}
+
+// ServeAttachment streams a file kept by the local storage driver once its signed link is verified.
+// With the S3 driver links point at the bucket directly, so nothing is served here.
+func (h *AttachmentHandler) ServeAttachment(c *gin.Context) {
+	local, ok := newFileStorage().(*storage.Local)
+	if !ok {
+		c.JSON(http.StatusNotFound, format.NotFound("attachment not found"))
+		return
+	}
+
+	key := strings.TrimPrefix(c.Param("key"), "/")
+
+	file, err := local.Open(key, c.Query("expires"), c.Query("signature"))
+	if err != nil {
+		switch err {
+		case storage.ErrInvalidSignature:
+			c.JSON(http.StatusForbidden, format.Forbidden(err.Error()))
+			return
+		case storage.ErrInvalidKey, storage.ErrObjectNotFound:
+			c.JSON(http.StatusNotFound, format.NotFound("attachment not found"))
+			return
+		default:
+			h.logger.Error("failed to open attachment", zap.Error(err))
+			c.JSON(http.StatusInternalServerError, format.InternalServerError())
+			return
+		}
+	}
+
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		h.logger.Error("failed to stat attachment", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, format.InternalServerError())
+		return
+	}
+
+	c.Header("X-Content-Type-Options", "nosniff")
+
+	http.ServeContent(c.Writer, c.Request, filepath.Base(key), info.ModTime(), file)
+}
+
+// newFileStorage picks the attachment storage driver from the STORAGE_DRIVER setting, local by default
+func newFileStorage() storage.Storage {
+	if config.GetString("STORAGE_DRIVER") == "s3" {
+		return storage.NewS3(storage.S3Config{
+			Endpoint:  config.GetString("STORAGE_S3_ENDPOINT"),
+			Region:    config.GetString("STORAGE_S3_REGION"),
+			Bucket:    config.GetString("STORAGE_S3_BUCKET"),
+			AccessKey: config.GetString("STORAGE_S3_ACCESS_KEY"),
+			SecretKey: config.GetString("STORAGE_S3_SECRET_KEY"),
+			PathStyle: config.GetBool("STORAGE_S3_PATH_STYLE"),
+		})
+	}
+
+	dir := config.GetString("STORAGE_LOCAL_DIR")
+	if dir == "" {
+		dir = "storage"
+	}
+
+	baseURL := config.GetString("STORAGE_LOCAL_URL")
+	if baseURL == "" {
+		baseURL = "http://localhost:8000/v1/attachments"
+	}
+
+	// Links are signed with the JWT secret unless a dedicated one is set
+	secret := config.GetString("STORAGE_SECRET")
+	if secret == "" {
+		secret = config.GetString("JWT_SECRET")
+	}
+
+	return storage.NewLocal(dir, baseURL, secret)
+}
@@ -0,0 +1,237 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/arvinpaundra/private-api/core/format"
+	"github.com/arvinpaundra/private-api/core/validator"
+	"github.com/arvinpaundra/private-api/domain/bank/constant"
+	"github.com/arvinpaundra/private-api/domain/bank/service"
+	moduleconstant "github.com/arvinpaundra/private-api/domain/module/constant"
+	"github.com/arvinpaundra/private-api/infrastructure/bank"
+	"github.com/arvinpaundra/private-api/infrastructure/shared"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+type BankHandler struct {
+	db     *gorm.DB
+	logger *zap.Logger
+	vld    *validator.Validator
+}
+
+func NewBankHandler(
+	db *gorm.DB,
+	logger *zap.Logger,
+	vld *validator.Validator,
+) *BankHandler {
+	return &BankHandler{
+		db:     db,
+		logger: logger.With(zap.String("domain", "bank")),
+		vld:    vld,
+	}
+}
+
+func (h *BankHandler) CreateBankQuestion(c *gin.Context) {
+	var command service.CreateBankQuestionCommand
+
+	err := c.ShouldBindJSON(&command)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, format.UnprocessableEntity(err.Error()))
+		return
+	}
+
+	verrs := h.vld.Validate(command)
+	if verrs != nil {
+		c.JSON(http.StatusBadRequest, format.BadRequest("invalid request body", verrs))
+		return
+	}
+
+	authStorage := shared.NewAuthStorage(c)
+
+	svc := service.NewCreateBankQuestion(
+		authStorage,
+		bank.NewBankQuestionWriterRepository(h.db),
+		bank.NewSubjectACLAdapter(h.db, authStorage),
+		bank.NewGradeACLAdapter(h.db, authStorage),
+		bank.NewModuleACLAdapter(h.db, authStorage),
+	)
+
+	id, err := svc.Execute(c.Request.Context(), &command)
+	if err != nil {
+		h.logger.Error("failed to create bank question", zap.Error(err))
+
+		switch {
+		case err == constant.ErrSubjectNotFound, err == constant.ErrGradeNotFound:
+			c.JSON(http.StatusNotFound, format.NotFound(err.Error()))
+			return
+		case isInvalidQuestion(err):
+			c.JSON(http.StatusBadRequest, format.BadRequest(err.Error(), nil))
+			return
+		default:
+			c.JSON(http.StatusInternalServerError, format.InternalServerError())
+			return
+		}
+	}
+
+	c.JSON(http.StatusCreated, format.SuccessCreated("bank question created successfully", gin.H{
+		"id": id,
+	}))
+}
+
+func (h *BankHandler) UpdateBankQuestion(c *gin.Context) {
+	var command service.UpdateBankQuestionCommand
+
+	err := c.ShouldBindJSON(&command)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, format.UnprocessableEntity(err.Error()))
+		return
+	}
+
+	command.ID = c.Param("id")
+
+	verrs := h.vld.Validate(command)
+	if verrs != nil {
+		c.JSON(http.StatusBadRequest, format.BadRequest("invalid request body", verrs))
+		return
+	}
+
+	authStorage := shared.NewAuthStorage(c)
+
+	svc := service.NewUpdateBankQuestion(
+		authStorage,
+		bank.NewBankQuestionReaderRepository(h.db),
+		bank.NewBankQuestionWriterRepository(h.db),
+		bank.NewSubjectACLAdapter(h.db, authStorage),
+		bank.NewGradeACLAdapter(h.db, authStorage),
+		bank.NewModuleACLAdapter(h.db, authStorage),
+	)
+
+	err = svc.Execute(c.Request.Context(), &command)
+	if err != nil {
+		h.logger.Error("failed to update bank question", zap.Error(err))
+
+		switch {
+		case err == constant.ErrBankQuestionNotFound, err == constant.ErrSubjectNotFound, err == constant.ErrGradeNotFound:
+			c.JSON(http.StatusNotFound, format.NotFound(err.Error()))
+			return
+		case isInvalidQuestion(err):
+			c.JSON(http.StatusBadRequest, format.BadRequest(err.Error(), nil))
+			return
+		default:
+			c.JSON(http.StatusInternalServerError, format.InternalServerError())
+			return
+		}
+	}
+
+	c.JSON(http.StatusOK, format.SuccessOK("bank question updated successfully", nil))
+}
+
+func (h *BankHandler) FindDetailBankQuestion(c *gin.Context) {
+	command := service.FindDetailBankQuestionCommand{
+		ID: c.Param("id"),
+	}
+
+	verrs := h.vld.Validate(command)
+	if verrs != nil {
+		c.JSON(http.StatusBadRequest, format.BadRequest("invalid request", verrs))
+		return
+	}
+
+	svc := service.NewFindDetailBankQuestion(
+		shared.NewAuthStorage(c),
+		bank.NewBankQuestionReaderRepository(h.db),
+	)
+
+	result, err := svc.Execute(c.Request.Context(), &command)
+	if err != nil {
+		h.logger.Error("failed to find detail bank question", zap.Error(err))
+
+		switch err {
+		case constant.ErrBankQuestionNotFound:
+			c.JSON(http.StatusNotFound, format.NotFound(err.Error()))
+			return
+		default:
+			c.JSON(http.StatusInternalServerError, format.InternalServerError())
+			return
+		}
+	}
+
+	c.JSON(http.StatusOK, format.SuccessOK("bank question detail fetched successfully", result))
+}
+
+func (h *BankHandler) FindAllBankQuestions(c *gin.Context) {
+	var command service.FindAllBankQuestionsCommand
+
+	err := c.ShouldBindQuery(&command)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, format.UnprocessableEntity(err.Error()))
+		return
+	}
+
+	svc := service.NewFindAllBankQuestions(
+		shared.NewAuthStorage(c),
+		bank.NewBankQuestionReaderRepository(h.db),
+	)
+
+	result, err := svc.Execute(c.Request.Context(), &command)
+	if err != nil {
+		h.logger.Error("failed to find all bank questions", zap.Error(err))
+
+		c.JSON(http.StatusInternalServerError, format.InternalServerError())
+		return
+	}
+
+	c.JSON(http.StatusOK, format.SuccessOK("bank questions fetched successfully", result))
+}
+
+func (h *BankHandler) DeleteBankQuestion(c *gin.Context) {
+	command := service.DeleteBankQuestionCommand{
+		ID: c.Param("id"),
+	}
+
+	verrs := h.vld.Validate(command)
+	if verrs != nil {
+		c.JSON(http.StatusBadRequest, format.BadRequest("invalid request", verrs))
+		return
+	}
+
+	svc := service.NewDeleteBankQuestion(
+		shared.NewAuthStorage(c),
+		bank.NewBankQuestionReaderRepository(h.db),
+		bank.NewBankQuestionWriterRepository(h.db),
+	)
+
+	err := svc.Execute(c.Request.Context(), &command)
+	if err != nil {
+		h.logger.Error("failed to delete bank question", zap.Error(err))
+
+		switch err {
+		case constant.ErrBankQuestionNotFound:
+			c.JSON(http.StatusNotFound, format.NotFound(err.Error()))
+			return
+		default:
+			c.JSON(http.StatusInternalServerError, format.InternalServerError())
+			return
+		}
+	}
+
+	c.JSON(http.StatusOK, format.SuccessOK("bank question deleted successfully", nil))
+}
+
+// isInvalidQuestion reports whether the module domain rejected the question answers
+func isInvalidQuestion(err error) bool {
+	switch err {
+	case moduleconstant.ErrMinTwoChoices, moduleconstant.ErrMaxFourChoices, moduleconstant.ErrMultipleCorrectAnswers, moduleconstant.ErrNoCorrectAnswer,
+		moduleconstant.ErrChoicesNotAllowed, moduleconstant.ErrPairsNotAllowed, moduleconstant.ErrQuestionTypeNotAllowed,
+		moduleconstant.ErrMinOneAcceptedAnswer, moduleconstant.ErrMaxTenAcceptedAnswers, moduleconstant.ErrInvalidAnswerPattern, moduleconstant.ErrAcceptedAnswersNotAllowed,
+		moduleconstant.ErrNumericValueRequired, moduleconstant.ErrNegativeTolerance, moduleconstant.ErrMaxTenUnits, moduleconstant.ErrDuplicateUnit, moduleconstant.ErrUnitsNotAllowed,
+		moduleconstant.ErrMinTwoItems, moduleconstant.ErrMaxTenItems, moduleconstant.ErrInvalidCorrectOrder, moduleconstant.ErrCorrectAnswerNotAllowed,
+		moduleconstant.ErrMinTwoPairs, moduleconstant.ErrMaxTenPairs, moduleconstant.ErrDuplicatePairItem,
+		moduleconstant.ErrQuestionContentTooLong, moduleconstant.ErrChoiceContentTooLong, moduleconstant.ErrPairContentTooLong:
+		return true
+	default:
+		return false
+	}
+}
@@ -1,7 +1,11 @@
 package handler
 
 import (
+	"fmt"
 	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
 
 	"github.com/arvinpaundra/private-api/core/format"
 	"github.com/arvinpaundra/private-api/core/validator"
@@ -62,54 +66,616 @@ func (h *ModuleHandler) CreateModule(c *gin.Context) {
 		case constant.ErrSubjectNotFound, constant.ErrGradeNotFound, constant.ErrModuleNotFound:
 			c.JSON(http.StatusNotFound, format.NotFound(err.Error()))
 			return
+		case constant.ErrInvalidSchedule:
+			c.JSON(http.StatusBadRequest, format.BadRequest(err.Error(), nil))
+			return
+		default:
+			c.JSON(http.StatusInternalServerError, format.InternalServerError())
+			return
+		}
+	}
+
+	c.JSON(http.StatusCreated, format.SuccessCreated("module created successfully", gin.H{
+		"slug": slug,
+	}))
+}
+
+func (h *ModuleHandler) UpdateModule(c *gin.Context) {
+	var command service.UpdateModuleCommand
+
+	err := c.ShouldBindJSON(&command)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, format.UnprocessableEntity(err.Error()))
+		return
+	}
+
+	// Set module slug from URL param
+	command.Slug = c.Param("module_slug")
+
+	verrs := h.vld.Validate(command)
+	if verrs != nil {
+		c.JSON(http.StatusBadRequest, format.BadRequest("invalid request body", verrs))
+		return
+	}
+
+	svc := service.NewUpdateModule(
+		shared.NewAuthStorage(c),
+		module.NewModuleReaderRepository(h.db),
+		module.NewModuleWriterRepository(h.db),
+		module.NewSubjectACLAdapter(h.db, shared.NewAuthStorage(c)),
+		module.NewGradeACLAdapter(h.db, shared.NewAuthStorage(c)),
+	)
+
+	err = svc.Execute(c.Request.Context(), &command)
+	if err != nil {
+		h.logger.Error("failed to update module", zap.Error(err))
+
+		switch err {
+		case constant.ErrSubjectNotFound, constant.ErrGradeNotFound, constant.ErrModuleNotFound:
+			c.JSON(http.StatusNotFound, format.NotFound(err.Error()))
+			return
+		default:
+			c.JSON(http.StatusInternalServerError, format.InternalServerError())
+			return
+		}
+	}
+
+	c.JSON(http.StatusOK, format.SuccessOK("module updated successfully", nil))
+}
+
+func (h *ModuleHandler) UpdateModuleSchedule(c *gin.Context) {
+	var command service.UpdateModuleScheduleCommand
+
+	err := c.ShouldBindJSON(&command)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, format.UnprocessableEntity(err.Error()))
+		return
+	}
+
+	// Set module slug from URL param
+	command.Slug = c.Param("module_slug")
+
+	verrs := h.vld.Validate(command)
+	if verrs != nil {
+		c.JSON(http.StatusBadRequest, format.BadRequest("invalid request body", verrs))
+		return
+	}
+
+	svc := service.NewUpdateModuleSchedule(
+		shared.NewAuthStorage(c),
+		module.NewModuleReaderRepository(h.db),
+		module.NewModuleWriterRepository(h.db),
+	)
+
+	err = svc.Execute(c.Request.Context(), &command)
+	if err != nil {
+		h.logger.Error("failed to update module schedule", zap.Error(err))
+
+		switch err {
+		case constant.ErrModuleNotFound:
+			c.JSON(http.StatusNotFound, format.NotFound(err.Error()))
+			return
+		case constant.ErrInvalidSchedule:
+			c.JSON(http.StatusBadRequest, format.BadRequest(err.Error(), nil))
+			return
+		default:
+			c.JSON(http.StatusInternalServerError, format.InternalServerError())
+			return
+		}
+	}
+
+	c.JSON(http.StatusOK, format.SuccessOK("module schedule updated successfully", nil))
+}
+
+func (h *ModuleHandler) CloneModule(c *gin.Context) {
+	var command service.CloneModuleCommand
+
+	// Overrides are optional, so an empty body is accepted
+	if c.Request.ContentLength != 0 {
+		err := c.ShouldBindJSON(&command)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, format.UnprocessableEntity(err.Error()))
+			return
+		}
+	}
+
+	// Set module slug from URL param
+	command.Slug = c.Param("module_slug")
+
+	verrs := h.vld.Validate(command)
+	if verrs != nil {
+		c.JSON(http.StatusBadRequest, format.BadRequest("invalid request body", verrs))
+		return
+	}
+
+	svc := service.NewCloneModule(
+		shared.NewAuthStorage(c),
+		module.NewModuleReaderRepository(h.db),
+		module.NewUnitOfWork(h.db),
+		module.NewSubjectACLAdapter(h.db, shared.NewAuthStorage(c)),
+		module.NewGradeACLAdapter(h.db, shared.NewAuthStorage(c)),
+	)
+
+	slug, err := svc.Execute(c.Request.Context(), &command)
+	if err != nil {
+		h.logger.Error("failed to clone module", zap.Error(err))
+
+		switch err {
+		case constant.ErrSubjectNotFound, constant.ErrGradeNotFound, constant.ErrModuleNotFound:
+			c.JSON(http.StatusNotFound, format.NotFound(err.Error()))
+			return
+		default:
+			c.JSON(http.StatusInternalServerError, format.InternalServerError())
+			return
+		}
+	}
+
+	c.JSON(http.StatusCreated, format.SuccessCreated("module cloned successfully", gin.H{
+		"slug": slug,
+	}))
+}
+
+// ExportModule downloads the module as a JSON document that ImportModule accepts as is,
+// its questions as a GIFT or Aiken file, or its published version as a QTI package,
+// when asked for with the format query
+func (h *ModuleHandler) ExportModule(c *gin.Context) {
+	switch fileFormat := constant.FileFormat(c.Query("format")); fileFormat {
+	case "", constant.JSONFile:
+		// written below
+	case constant.QTIFile:
+		h.exportPackage(c)
+		return
+	default:
+		h.exportQuestions(c, fileFormat)
+		return
+	}
+
+	command := service.ExportModuleCommand{
+		Slug: c.Param("module_slug"),
+	}
+
+	verrs := h.vld.Validate(command)
+	if verrs != nil {
+		c.JSON(http.StatusBadRequest, format.BadRequest("invalid request", verrs))
+		return
+	}
+
+	svc := service.NewExportModule(
+		shared.NewAuthStorage(c),
+		module.NewModuleReaderRepository(h.db),
+		module.NewSubjectACLAdapter(h.db, shared.NewAuthStorage(c)),
+		module.NewGradeACLAdapter(h.db, shared.NewAuthStorage(c)),
+	)
+
+	result, err := svc.Execute(c.Request.Context(), &command)
+	if err != nil {
+		h.logger.Error("failed to export module", zap.Error(err))
+
+		switch err {
+		case constant.ErrModuleNotFound:
+			c.JSON(http.StatusNotFound, format.NotFound(err.Error()))
+			return
+		default:
+			c.JSON(http.StatusInternalServerError, format.InternalServerError())
+			return
+		}
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s.json"`, command.Slug))
+	c.JSON(http.StatusOK, result)
+}
+
+func (h *ModuleHandler) exportQuestions(c *gin.Context, fileFormat constant.FileFormat) {
+	command := service.ExportQuestionsCommand{
+		Slug:   c.Param("module_slug"),
+		Format: fileFormat,
+	}
+
+	verrs := h.vld.Validate(command)
+	if verrs != nil {
+		c.JSON(http.StatusBadRequest, format.BadRequest("invalid request", verrs))
+		return
+	}
+
+	svc := service.NewExportQuestions(
+		shared.NewAuthStorage(c),
+		module.NewModuleReaderRepository(h.db),
+	)
+
+	result, err := svc.Execute(c.Request.Context(), &command)
+	if err != nil {
+		h.logger.Error("failed to export questions", zap.Error(err))
+
+		switch err {
+		case constant.ErrModuleNotFound:
+			c.JSON(http.StatusNotFound, format.NotFound(err.Error()))
+			return
+		case constant.ErrUnexportableQuestions:
+			// Report every question the format cannot hold, keyed by its position
+			c.JSON(http.StatusBadRequest, format.BadRequest(err.Error(), result.Errors))
+			return
+		default:
+			c.JSON(http.StatusInternalServerError, format.InternalServerError())
+			return
+		}
+	}
+
+	// Moodle imports Aiken files by the .txt extension
+	extension := "gift"
+	if fileFormat == constant.AikenFile {
+		extension = "txt"
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s.%s"`, command.Slug, extension))
+	c.String(http.StatusOK, result.Content)
+}
+
+func (h *ModuleHandler) exportPackage(c *gin.Context) {
+	command := service.ExportPackageCommand{
+		Slug: c.Param("module_slug"),
+	}
+
+	verrs := h.vld.Validate(command)
+	if verrs != nil {
+		c.JSON(http.StatusBadRequest, format.BadRequest("invalid request", verrs))
+		return
+	}
+
+	svc := service.NewExportPackage(
+		shared.NewAuthStorage(c),
+		module.NewModuleReaderRepository(h.db),
+	)
+
+	result, err := svc.Execute(c.Request.Context(), &command)
+	if err != nil {
+		h.logger.Error("failed to export qti package", zap.Error(err))
+
+		switch err {
+		case constant.ErrModuleNotFound, constant.ErrVersionNotFound:
+			c.JSON(http.StatusNotFound, format.NotFound(err.Error()))
+			return
+		case constant.ErrModuleNotPublished:
+			c.JSON(http.StatusBadRequest, format.BadRequest(err.Error(), nil))
+			return
+		case constant.ErrNoExportableQuestions:
+			// Report why each question was left out, keyed by its position
+			c.JSON(http.StatusBadRequest, format.BadRequest(err.Error(), result.Errors))
+			return
+		default:
+			c.JSON(http.StatusInternalServerError, format.InternalServerError())
+			return
+		}
+	}
+
+	// Questions other than choice questions are left out, their positions listed for the client
+	if len(result.Skipped) > 0 {
+		positions := make([]string, len(result.Skipped))
+		for i, position := range result.Skipped {
+			positions[i] = strconv.Itoa(position)
+		}
+
+		c.Header("X-Skipped-Questions", strings.Join(positions, ","))
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s.zip"`, command.Slug))
+	c.Data(http.StatusOK, "application/zip", result.Content)
+}
+
+func (h *ModuleHandler) ImportModule(c *gin.Context) {
+	var command service.ImportModuleCommand
+
+	err := c.ShouldBindJSON(&command)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, format.UnprocessableEntity(err.Error()))
+		return
+	}
+
+	verrs := h.vld.Validate(command)
+	if verrs != nil {
+		c.JSON(http.StatusBadRequest, format.BadRequest("invalid request body", verrs))
+		return
+	}
+
+	svc := service.NewImportModule(
+		shared.NewAuthStorage(c),
+		module.NewUnitOfWork(h.db),
+		module.NewSubjectACLAdapter(h.db, shared.NewAuthStorage(c)),
+		module.NewGradeACLAdapter(h.db, shared.NewAuthStorage(c)),
+	)
+
+	slug, err := svc.Execute(c.Request.Context(), &command)
+	if err != nil {
+		h.logger.Error("failed to import module", zap.Error(err))
+
+		switch {
+		case err == constant.ErrUnsupportedSchemaVersion, isInvalidQuestion(err):
+			c.JSON(http.StatusBadRequest, format.BadRequest(err.Error(), nil))
+			return
+		default:
+			c.JSON(http.StatusInternalServerError, format.InternalServerError())
+			return
+		}
+	}
+
+	c.JSON(http.StatusCreated, format.SuccessCreated("module imported successfully", gin.H{
+		"slug": slug,
+	}))
+}
+
+// ImportPackage creates a module from the choice items of a QTI 2.1 content package
+func (h *ModuleHandler) ImportPackage(c *gin.Context) {
+	// Leave room for the multipart envelope around the largest accepted package
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, constant.MaxPackageSize+1<<20)
+
+	var command service.ImportPackageCommand
+
+	err := c.ShouldBind(&command)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, format.UnprocessableEntity(err.Error()))
+		return
+	}
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, format.UnprocessableEntity(err.Error()))
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, format.UnprocessableEntity(err.Error()))
+		return
+	}
+
+	defer file.Close()
+
+	command.File = file
+
+	verrs := h.vld.Validate(command)
+	if verrs != nil {
+		c.JSON(http.StatusBadRequest, format.BadRequest("invalid request body", verrs))
+		return
+	}
+
+	svc := service.NewImportPackage(
+		shared.NewAuthStorage(c),
+		module.NewUnitOfWork(h.db),
+		module.NewSubjectACLAdapter(h.db, shared.NewAuthStorage(c)),
+		module.NewGradeACLAdapter(h.db, shared.NewAuthStorage(c)),
+	)
+
+	result, err := svc.Execute(c.Request.Context(), &command)
+	if err != nil {
+		h.logger.Error("failed to import qti package", zap.Error(err))
+
+		switch err {
+		case constant.ErrSubjectNotFound, constant.ErrGradeNotFound:
+			c.JSON(http.StatusNotFound, format.NotFound(err.Error()))
+			return
+		case constant.ErrNoImportableItems:
+			// Report why each item was left out, keyed by its file in the package
+			c.JSON(http.StatusBadRequest, format.BadRequest(err.Error(), result.Skipped))
+			return
+		case constant.ErrPackageTooLarge, constant.ErrInvalidPackage, constant.ErrMaxImportRows:
+			c.JSON(http.StatusBadRequest, format.BadRequest(err.Error(), nil))
+			return
+		default:
+			c.JSON(http.StatusInternalServerError, format.InternalServerError())
+			return
+		}
+	}
+
+	c.JSON(http.StatusCreated, format.SuccessCreated("package imported successfully", result))
+}
+
+func (h *ModuleHandler) FindAllModules(c *gin.Context) {
+	var command service.FindAllModulesCommand
+
+	err := c.ShouldBindQuery(&command)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, format.UnprocessableEntity(err.Error()))
+		return
+	}
+
+	svc := service.NewFindAllModules(
+		shared.NewAuthStorage(c),
+		module.NewModuleReaderRepository(h.db),
+		module.NewSubjectACLAdapter(h.db, shared.NewAuthStorage(c)),
+		module.NewGradeACLAdapter(h.db, shared.NewAuthStorage(c)),
+	)
+
+	result, err := svc.Execute(c.Request.Context(), &command)
+	if err != nil {
+		h.logger.Error("failed to find all modules", zap.Error(err))
+
+		switch err {
+		case constant.ErrSubjectNotFound, constant.ErrGradeNotFound, constant.ErrModuleNotFound:
+			c.JSON(http.StatusNotFound, format.NotFound(err.Error()))
+			return
+		default:
+			c.JSON(http.StatusInternalServerError, format.InternalServerError())
+			return
+		}
+	}
+
+	c.JSON(http.StatusOK, format.SuccessOK("modules retrieved successfully", result))
+}
+
+func (h *ModuleHandler) AddQuestions(c *gin.Context) {
+	moduleSlug := c.Param("module_slug")
+
+	var command service.AddQuestionsCommand
+
+	err := c.ShouldBindJSON(&command)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, format.UnprocessableEntity(err.Error()))
+		return
+	}
+
+	// Set module slug from URL param
+	command.ModuleSlug = moduleSlug
+
+	verrs := h.vld.Validate(command)
+	if verrs != nil {
+		c.JSON(http.StatusBadRequest, format.BadRequest("invalid request body", verrs))
+		return
+	}
+
+	svc := service.NewAddQuestions(
+		shared.NewAuthStorage(c),
+		module.NewModuleReaderRepository(h.db),
+		module.NewUnitOfWork(h.db),
+	)
+
+	err = svc.Execute(c.Request.Context(), &command)
+	if err != nil {
+		h.logger.Error("failed to add questions", zap.Error(err))
+
+		switch err {
+		case constant.ErrModuleNotFound, constant.ErrQuestionNotFound:
+			c.JSON(http.StatusNotFound, format.NotFound(err.Error()))
+			return
+		case constant.ErrMinTwoChoices, constant.ErrMaxFourChoices, constant.ErrMultipleCorrectAnswers, constant.ErrNoCorrectAnswer,
+			constant.ErrChoicesNotAllowed, constant.ErrPairsNotAllowed, constant.ErrQuestionTypeNotAllowed,
+			constant.ErrMinOneAcceptedAnswer, constant.ErrMaxTenAcceptedAnswers, constant.ErrInvalidAnswerPattern, constant.ErrAcceptedAnswersNotAllowed,
+			constant.ErrNumericValueRequired, constant.ErrNegativeTolerance, constant.ErrMaxTenUnits, constant.ErrDuplicateUnit, constant.ErrUnitsNotAllowed,
+			constant.ErrMinTwoItems, constant.ErrMaxTenItems, constant.ErrInvalidCorrectOrder, constant.ErrCorrectAnswerNotAllowed,
+			constant.ErrMinTwoPairs, constant.ErrMaxTenPairs, constant.ErrDuplicatePairItem,
+			constant.ErrQuestionContentTooLong, constant.ErrChoiceContentTooLong, constant.ErrPairContentTooLong:
+			c.JSON(http.StatusBadRequest, format.BadRequest(err.Error(), nil))
+			return
+		default:
+			c.JSON(http.StatusInternalServerError, format.InternalServerError())
+			return
+		}
+	}
+
+	c.JSON(http.StatusCreated, format.SuccessCreated("question(s) added successfully", nil))
+}
+
+func (h *ModuleHandler) AddBankQuestions(c *gin.Context) {
+	moduleSlug := c.Param("module_slug")
+
+	var command service.AddBankQuestionsCommand
+
+	err := c.ShouldBindJSON(&command)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, format.UnprocessableEntity(err.Error()))
+		return
+	}
+
+	// Set module slug from URL param
+	command.ModuleSlug = moduleSlug
+
+	verrs := h.vld.Validate(command)
+	if verrs != nil {
+		c.JSON(http.StatusBadRequest, format.BadRequest("invalid request body", verrs))
+		return
+	}
+
+	svc := service.NewAddBankQuestions(
+		shared.NewAuthStorage(c),
+		module.NewModuleReaderRepository(h.db),
+		module.NewBankACLAdapter(h.db),
+		module.NewUnitOfWork(h.db),
+	)
+
+	err = svc.Execute(c.Request.Context(), &command)
+	if err != nil {
+		h.logger.Error("failed to add bank questions", zap.Error(err))
+
+		switch err {
+		case constant.ErrModuleNotFound, constant.ErrBankQuestionNotFound:
+			c.JSON(http.StatusNotFound, format.NotFound(err.Error()))
+			return
+		case constant.ErrQuestionTypeNotAllowed:
+			c.JSON(http.StatusBadRequest, format.BadRequest(err.Error(), nil))
+			return
 		default:
 			c.JSON(http.StatusInternalServerError, format.InternalServerError())
 			return
 		}
 	}
 
-	c.JSON(http.StatusCreated, format.SuccessCreated("module created successfully", gin.H{
-		"slug": slug,
-	}))
+	c.JSON(http.StatusCreated, format.SuccessCreated("bank question(s) added successfully", nil))
 }
 
-func (h *ModuleHandler) FindAllModules(c *gin.Context) {
-	var command service.FindAllModulesCommand
+func (h *ModuleHandler) ImportQuestions(c *gin.Context) {
+	// Leave room for the multipart envelope around the largest accepted file
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, constant.MaxImportFileSize+1<<20)
 
-	err := c.ShouldBindQuery(&command)
+	var command service.ImportQuestionsCommand
+
+	err := c.ShouldBind(&command)
 	if err != nil {
 		c.JSON(http.StatusBadRequest, format.UnprocessableEntity(err.Error()))
 		return
 	}
 
-	svc := service.NewFindAllModules(
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, format.UnprocessableEntity(err.Error()))
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, format.UnprocessableEntity(err.Error()))
+		return
+	}
+
+	defer file.Close()
+
+	command.ModuleSlug = c.Param("module_slug")
+	command.Filename = filepath.Base(fileHeader.Filename)
+	command.File = file
+
+	verrs := h.vld.Validate(command)
+	if verrs != nil {
+		c.JSON(http.StatusBadRequest, format.BadRequest("invalid request body", verrs))
+		return
+	}
+
+	svc := service.NewImportQuestions(
 		shared.NewAuthStorage(c),
 		module.NewModuleReaderRepository(h.db),
-		module.NewSubjectACLAdapter(h.db, shared.NewAuthStorage(c)),
-		module.NewGradeACLAdapter(h.db, shared.NewAuthStorage(c)),
+		module.NewUnitOfWork(h.db),
 	)
 
 	result, err := svc.Execute(c.Request.Context(), &command)
 	if err != nil {
-		h.logger.Error("failed to find all modules", zap.Error(err))
+		h.logger.Error("failed to import questions", zap.Error(err))
 
 		switch err {
-		case constant.ErrSubjectNotFound, constant.ErrGradeNotFound, constant.ErrModuleNotFound:
+		case constant.ErrModuleNotFound:
 			c.JSON(http.StatusNotFound, format.NotFound(err.Error()))
 			return
+		case constant.ErrInvalidImportRows:
+			// Report every invalid question, keyed by its row or line in the file
+			c.JSON(http.StatusBadRequest, format.BadRequest(err.Error(), result.Errors))
+			return
+		case constant.ErrImportFileTooLarge, constant.ErrUnsupportedImportFile, constant.ErrUnreadableImportFile,
+			constant.ErrMissingImportColumns, constant.ErrEmptyImport, constant.ErrMaxImportRows:
+			c.JSON(http.StatusBadRequest, format.BadRequest(err.Error(), nil))
+			return
 		default:
 			c.JSON(http.StatusInternalServerError, format.InternalServerError())
 			return
 		}
 	}
 
-	c.JSON(http.StatusOK, format.SuccessOK("modules retrieved successfully", result))
+	if result.DryRun {
+		c.JSON(http.StatusOK, format.SuccessOK("question(s) are valid, nothing was imported", result))
+		return
+	}
+
+	c.JSON(http.StatusCreated, format.SuccessCreated("question(s) imported successfully", result))
 }
 
-func (h *ModuleHandler) AddQuestions(c *gin.Context) {
+func (h *ModuleHandler) ReorderQuestions(c *gin.Context) {
 	moduleSlug := c.Param("module_slug")
 
-	var command service.AddQuestionsCommand
+	var command service.ReorderQuestionsCommand
 
 	err := c.ShouldBindJSON(&command)
 	if err != nil {
@@ -126,7 +692,7 @@ func (h *ModuleHandler) AddQuestions(c *gin.Context) {
 		return
 	}
 
-	svc := service.NewAddQuestions(
+	svc := service.NewReorderQuestions(
 		shared.NewAuthStorage(c),
 		module.NewModuleReaderRepository(h.db),
 		module.NewUnitOfWork(h.db),
@@ -134,13 +700,13 @@ func (h *ModuleHandler) AddQuestions(c *gin.Context) {
 
 	err = svc.Execute(c.Request.Context(), &command)
 	if err != nil {
-		h.logger.Error("failed to add questions", zap.Error(err))
+		h.logger.Error("failed to reorder questions", zap.Error(err))
 
 		switch err {
-		case constant.ErrModuleNotFound, constant.ErrQuestionNotFound:
+		case constant.ErrModuleNotFound:
 			c.JSON(http.StatusNotFound, format.NotFound(err.Error()))
 			return
-		case constant.ErrMinTwoChoices, constant.ErrMaxFourChoices, constant.ErrMultipleCorrectAnswers, constant.ErrNoCorrectAnswer:
+		case constant.ErrInvalidQuestionOrder:
 			c.JSON(http.StatusBadRequest, format.BadRequest(err.Error(), nil))
 			return
 		default:
@@ -149,7 +715,7 @@ func (h *ModuleHandler) AddQuestions(c *gin.Context) {
 		}
 	}
 
-	c.JSON(http.StatusCreated, format.SuccessCreated("question(s) added successfully", nil))
+	c.JSON(http.StatusOK, format.SuccessOK("questions reordered successfully", nil))
 }
 
 func (h *ModuleHandler) FindDetailModule(c *gin.Context) {
@@ -200,6 +766,9 @@ func (h *ModuleHandler) FindPublishedModule(c *gin.Context) {
 		case constant.ErrModuleNotFound:
 			c.JSON(http.StatusNotFound, format.NotFound(err.Error()))
 			return
+		case constant.ErrModuleNotOpen, constant.ErrModuleClosed:
+			c.JSON(http.StatusForbidden, format.Forbidden(err.Error()))
+			return
 		default:
 			c.JSON(http.StatusInternalServerError, format.InternalServerError())
 			return
@@ -221,6 +790,7 @@ func (h *ModuleHandler) FindDetailModuleQuestions(c *gin.Context) {
 		module.NewModuleReaderRepository(h.db),
 		module.NewSubjectACLAdapter(h.db, shared.NewAuthStorage(c)),
 		module.NewGradeACLAdapter(h.db, shared.NewAuthStorage(c)),
+		newFileStorage(),
 	)
 
 	result, err := svc.Execute(c.Request.Context(), &command)
@@ -256,7 +826,7 @@ func (h *ModuleHandler) TogglePublishModule(c *gin.Context) {
 	svc := service.NewTogglePublishModule(
 		shared.NewAuthStorage(c),
 		module.NewModuleReaderRepository(h.db),
-		module.NewModuleWriterRepository(h.db),
+		module.NewUnitOfWork(h.db),
 	)
 
 	err := svc.Execute(c.Request.Context(), &command)
@@ -276,15 +846,58 @@ func (h *ModuleHandler) TogglePublishModule(c *gin.Context) {
 	c.JSON(http.StatusOK, format.SuccessOK("module publish status toggled successfully", nil))
 }
 
+func (h *ModuleHandler) PublishModuleVersion(c *gin.Context) {
+	command := service.PublishModuleVersionCommand{
+		Slug: c.Param("module_slug"),
+	}
+
+	verrs := h.vld.Validate(command)
+	if verrs != nil {
+		c.JSON(http.StatusBadRequest, format.BadRequest("invalid request", verrs))
+		return
+	}
+
+	svc := service.NewPublishModuleVersion(
+		shared.NewAuthStorage(c),
+		module.NewModuleReaderRepository(h.db),
+		module.NewUnitOfWork(h.db),
+	)
+
+	version, err := svc.Execute(c.Request.Context(), &command)
+	if err != nil {
+		h.logger.Error("failed to publish module version", zap.Error(err))
+
+		switch err {
+		case constant.ErrModuleNotFound:
+			c.JSON(http.StatusNotFound, format.NotFound(err.Error()))
+			return
+		default:
+			c.JSON(http.StatusInternalServerError, format.InternalServerError())
+			return
+		}
+	}
+
+	c.JSON(http.StatusCreated, format.SuccessCreated("module version published successfully", gin.H{
+		"version": version,
+	}))
+}
+
 func (h *ModuleHandler) FindPublishedQuestion(c *gin.Context) {
 	moduleSlug := c.Param("module_slug")
 	questionSlug := c.Param("question_slug")
 
-	command := service.FindPublishedQuestionCommand{
-		ModuleSlug:   moduleSlug,
-		QuestionSlug: questionSlug,
+	var command service.FindPublishedQuestionCommand
+
+	// Optional version pins the question to the one a submission started on
+	err := c.ShouldBindQuery(&command)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, format.UnprocessableEntity(err.Error()))
+		return
 	}
 
+	command.ModuleSlug = moduleSlug
+	command.QuestionSlug = questionSlug
+
 	verrs := h.vld.Validate(command)
 	if verrs != nil {
 		c.JSON(http.StatusBadRequest, format.BadRequest("invalid request", verrs))
@@ -293,6 +906,8 @@ func (h *ModuleHandler) FindPublishedQuestion(c *gin.Context) {
 
 	svc := service.NewFindPublishedQuestion(
 		module.NewModuleReaderRepository(h.db),
+		module.NewSubmissionACLAdapter(h.db),
+		newFileStorage(),
 	)
 
 	result, err := svc.Execute(c.Request.Context(), &command)
@@ -300,9 +915,56 @@ func (h *ModuleHandler) FindPublishedQuestion(c *gin.Context) {
 		h.logger.Error("failed to find published question", zap.Error(err))
 
 		switch err {
-		case constant.ErrModuleNotFound, constant.ErrQuestionNotFound:
+		case constant.ErrModuleNotFound, constant.ErrQuestionNotFound, constant.ErrVersionNotFound, constant.ErrSubmissionNotFound:
+			c.JSON(http.StatusNotFound, format.NotFound(err.Error()))
+			return
+		case constant.ErrSubmissionRequired, constant.ErrVersionMismatch:
+			c.JSON(http.StatusBadRequest, format.BadRequest(err.Error(), nil))
+			return
+		case constant.ErrSubmissionExpired, constant.ErrSubmissionAlreadyDone:
+			c.JSON(http.StatusForbidden, format.Forbidden(err.Error()))
+			return
+		default:
+			c.JSON(http.StatusInternalServerError, format.InternalServerError())
+			return
+		}
+	}
+
+	c.JSON(http.StatusOK, format.SuccessOK("question retrieved successfully", result))
+}
+
+// FindPreviewQuestion serves a question of the user's own module from its draft, the way
+// FindPublishedQuestion serves it to the preview submission
+func (h *ModuleHandler) FindPreviewQuestion(c *gin.Context) {
+	command := service.FindPublishedQuestionCommand{
+		ModuleSlug:     c.Param("module_slug"),
+		QuestionSlug:   c.Param("question_slug"),
+		SubmissionCode: c.Param("submission_code"),
+	}
+
+	verrs := h.vld.Validate(command)
+	if verrs != nil {
+		c.JSON(http.StatusBadRequest, format.BadRequest("invalid request", verrs))
+		return
+	}
+
+	svc := service.NewFindPublishedQuestion(
+		module.NewPreviewReaderRepository(h.db, shared.NewAuthStorage(c).GetUserId()),
+		module.NewSubmissionACLAdapter(h.db),
+		newFileStorage(),
+	)
+
+	result, err := svc.Execute(c.Request.Context(), &command)
+	if err != nil {
+		h.logger.Error("failed to find preview question", zap.Error(err))
+
+		switch err {
+		case constant.ErrModuleNotFound, constant.ErrQuestionNotFound, constant.ErrSubmissionNotFound:
 			c.JSON(http.StatusNotFound, format.NotFound(err.Error()))
 			return
+		case constant.ErrSubmissionExpired, constant.ErrSubmissionAlreadyDone:
+			c.JSON(http.StatusForbidden, format.Forbidden(err.Error()))
+			return
 		default:
 			c.JSON(http.StatusInternalServerError, format.InternalServerError())
 			return
@@ -347,3 +1009,193 @@ func (h *ModuleHandler) DeleteModule(c *gin.Context) {
 
 	c.JSON(http.StatusOK, format.SuccessOK("module deleted successfully", nil))
 }
+
+func (h *ModuleHandler) UpdateQuestion(c *gin.Context) {
+	var command service.UpdateQuestionCommand
+
+	err := c.ShouldBindJSON(&command)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, format.UnprocessableEntity(err.Error()))
+		return
+	}
+
+	// Set identifiers from URL params
+	command.ModuleSlug = c.Param("module_slug")
+	command.QuestionID = c.Param("question_id")
+
+	verrs := h.vld.Validate(command)
+	if verrs != nil {
+		c.JSON(http.StatusBadRequest, format.BadRequest("invalid request body", verrs))
+		return
+	}
+
+	svc := service.NewUpdateQuestion(
+		shared.NewAuthStorage(c),
+		module.NewModuleReaderRepository(h.db),
+		module.NewUnitOfWork(h.db),
+	)
+
+	err = svc.Execute(c.Request.Context(), &command)
+	if err != nil {
+		h.logger.Error("failed to update question", zap.Error(err))
+
+		switch err {
+		case constant.ErrModuleNotFound, constant.ErrQuestionNotFound:
+			c.JSON(http.StatusNotFound, format.NotFound(err.Error()))
+			return
+		case constant.ErrMinTwoChoices, constant.ErrMaxFourChoices, constant.ErrMultipleCorrectAnswers, constant.ErrNoCorrectAnswer,
+			constant.ErrChoicesNotAllowed, constant.ErrPairsNotAllowed, constant.ErrQuestionTypeNotAllowed,
+			constant.ErrMinOneAcceptedAnswer, constant.ErrMaxTenAcceptedAnswers, constant.ErrInvalidAnswerPattern, constant.ErrAcceptedAnswersNotAllowed,
+			constant.ErrNumericValueRequired, constant.ErrNegativeTolerance, constant.ErrMaxTenUnits, constant.ErrDuplicateUnit, constant.ErrUnitsNotAllowed,
+			constant.ErrMinTwoItems, constant.ErrMaxTenItems, constant.ErrInvalidCorrectOrder, constant.ErrCorrectAnswerNotAllowed,
+			constant.ErrMinTwoPairs, constant.ErrMaxTenPairs, constant.ErrDuplicatePairItem,
+			constant.ErrQuestionContentTooLong, constant.ErrChoiceContentTooLong, constant.ErrPairContentTooLong:
+			c.JSON(http.StatusBadRequest, format.BadRequest(err.Error(), nil))
+			return
+		default:
+			c.JSON(http.StatusInternalServerError, format.InternalServerError())
+			return
+		}
+	}
+
+	c.JSON(http.StatusOK, format.SuccessOK("question updated successfully", nil))
+}
+
+func (h *ModuleHandler) DeleteQuestion(c *gin.Context) {
+	command := service.DeleteQuestionCommand{
+		ModuleSlug: c.Param("module_slug"),
+		QuestionID: c.Param("question_id"),
+	}
+
+	verrs := h.vld.Validate(command)
+	if verrs != nil {
+		c.JSON(http.StatusBadRequest, format.BadRequest("invalid request", verrs))
+		return
+	}
+
+	svc := service.NewDeleteQuestion(
+		shared.NewAuthStorage(c),
+		module.NewModuleReaderRepository(h.db),
+		module.NewUnitOfWork(h.db),
+	)
+
+	err := svc.Execute(c.Request.Context(), &command)
+	if err != nil {
+		h.logger.Error("failed to delete question", zap.Error(err))
+
+		switch err {
+		case constant.ErrModuleNotFound, constant.ErrQuestionNotFound:
+			c.JSON(http.StatusNotFound, format.NotFound(err.Error()))
+			return
+		default:
+			c.JSON(http.StatusInternalServerError, format.InternalServerError())
+			return
+		}
+	}
+
+	c.JSON(http.StatusOK, format.SuccessOK("question deleted successfully", nil))
+}
+
+func (h *ModuleHandler) UploadAttachment(c *gin.Context) {
+	// Leave room for the multipart envelope around the largest accepted file
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, constant.MaxAttachmentSize+1<<20)
+
+	var command service.UploadAttachmentCommand
+
+	err := c.ShouldBind(&command)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, format.UnprocessableEntity(err.Error()))
+		return
+	}
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, format.UnprocessableEntity(err.Error()))
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, format.UnprocessableEntity(err.Error()))
+		return
+	}
+
+	defer file.Close()
+
+	command.ModuleSlug = c.Param("module_slug")
+	command.QuestionID = c.Param("question_id")
+	command.Filename = filepath.Base(fileHeader.Filename)
+	command.Size = fileHeader.Size
+	command.File = file
+
+	verrs := h.vld.Validate(command)
+	if verrs != nil {
+		c.JSON(http.StatusBadRequest, format.BadRequest("invalid request body", verrs))
+		return
+	}
+
+	svc := service.NewUploadAttachment(
+		shared.NewAuthStorage(c),
+		module.NewModuleReaderRepository(h.db),
+		module.NewUnitOfWork(h.db),
+		newFileStorage(),
+	)
+
+	result, err := svc.Execute(c.Request.Context(), &command)
+	if err != nil {
+		h.logger.Error("failed to upload attachment", zap.Error(err))
+
+		switch err {
+		case constant.ErrModuleNotFound, constant.ErrQuestionNotFound:
+			c.JSON(http.StatusNotFound, format.NotFound(err.Error()))
+			return
+		case constant.ErrChoiceNotFound,
+			constant.ErrUnsupportedAttachmentType,
+			constant.ErrAttachmentTooLarge,
+			constant.ErrMaxFiveAttachments:
+			c.JSON(http.StatusBadRequest, format.BadRequest(err.Error(), nil))
+			return
+		default:
+			c.JSON(http.StatusInternalServerError, format.InternalServerError())
+			return
+		}
+	}
+
+	c.JSON(http.StatusCreated, format.SuccessCreated("attachment uploaded successfully", result))
+}
+
+func (h *ModuleHandler) DeleteAttachment(c *gin.Context) {
+	command := service.DeleteAttachmentCommand{
+		ModuleSlug:   c.Param("module_slug"),
+		QuestionID:   c.Param("question_id"),
+		AttachmentID: c.Param("attachment_id"),
+	}
+
+	verrs := h.vld.Validate(command)
+	if verrs != nil {
+		c.JSON(http.StatusBadRequest, format.BadRequest("invalid request", verrs))
+		return
+	}
+
+	svc := service.NewDeleteAttachment(
+		shared.NewAuthStorage(c),
+		module.NewModuleReaderRepository(h.db),
+		module.NewUnitOfWork(h.db),
+	)
+
+	err := svc.Execute(c.Request.Context(), &command)
+	if err != nil {
+		h.logger.Error("failed to delete attachment", zap.Error(err))
+
+		switch err {
+		case constant.ErrModuleNotFound, constant.ErrQuestionNotFound, constant.ErrAttachmentNotFound:
+			c.JSON(http.StatusNotFound, format.NotFound(err.Error()))
+			return
+		default:
+			c.JSON(http.StatusInternalServerError, format.InternalServerError())
+			return
+		}
+	}
+
+	c.JSON(http.StatusOK, format.SuccessOK("attachment deleted successfully", nil))
+}
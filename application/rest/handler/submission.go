@@ -6,7 +6,9 @@ import (
 	"github.com/arvinpaundra/private-api/core/format"
 	"github.com/arvinpaundra/private-api/core/validator"
 	"github.com/arvinpaundra/private-api/domain/submission/constant"
+	"github.com/arvinpaundra/private-api/domain/submission/repository"
 	"github.com/arvinpaundra/private-api/domain/submission/service"
+	"github.com/arvinpaundra/private-api/infrastructure/shared"
 	"github.com/arvinpaundra/private-api/infrastructure/submission"
 	"github.com/gin-gonic/gin"
 	"go.uber.org/zap"
@@ -32,6 +34,14 @@ func NewSubmissionHandler(
 }
 
 func (h *SubmissionHandler) StartSubmission(c *gin.Context) {
+	h.startSubmission(c, submission.NewModuleACLAdapter(h.db, newFileStorage()))
+}
+
+func (h *SubmissionHandler) StartPreview(c *gin.Context) {
+	h.startSubmission(c, h.previewModuleACL(c))
+}
+
+func (h *SubmissionHandler) startSubmission(c *gin.Context, moduleACL repository.ModuleACL) {
 	var command service.StartSubmissionCommand
 
 	err := c.ShouldBindJSON(&command)
@@ -50,7 +60,7 @@ func (h *SubmissionHandler) StartSubmission(c *gin.Context) {
 
 	svc := service.NewStartSubmission(
 		submission.NewUnitOfWork(h.db),
-		submission.NewModuleACLAdapter(h.db),
+		moduleACL,
 	)
 
 	result, err := svc.Execute(c.Request.Context(), &command)
@@ -61,6 +71,9 @@ func (h *SubmissionHandler) StartSubmission(c *gin.Context) {
 		case constant.ErrModuleNotFound:
 			c.JSON(http.StatusNotFound, format.NotFound(err.Error()))
 			return
+		case constant.ErrModuleNotOpen, constant.ErrModuleClosed:
+			c.JSON(http.StatusForbidden, format.Forbidden(err.Error()))
+			return
 		default:
 			c.JSON(http.StatusInternalServerError, format.InternalServerError())
 			return
@@ -71,6 +84,14 @@ func (h *SubmissionHandler) StartSubmission(c *gin.Context) {
 }
 
 func (h *SubmissionHandler) SubmitAnswer(c *gin.Context) {
+	h.submitAnswer(c, submission.NewModuleACLAdapter(h.db, newFileStorage()))
+}
+
+func (h *SubmissionHandler) SubmitPreviewAnswer(c *gin.Context) {
+	h.submitAnswer(c, h.previewModuleACL(c))
+}
+
+func (h *SubmissionHandler) submitAnswer(c *gin.Context, moduleACL repository.ModuleACL) {
 	var command service.SubmitAnswerCommand
 
 	err := c.ShouldBindJSON(&command)
@@ -91,7 +112,7 @@ func (h *SubmissionHandler) SubmitAnswer(c *gin.Context) {
 	svc := service.NewSubmitAnswer(
 		submission.NewSubmissionReaderRepository(h.db),
 		submission.NewUnitOfWork(h.db),
-		submission.NewModuleACLAdapter(h.db),
+		moduleACL,
 	)
 
 	result, err := svc.Execute(c.Request.Context(), &command)
@@ -99,15 +120,18 @@ func (h *SubmissionHandler) SubmitAnswer(c *gin.Context) {
 		h.logger.Error("failed to submit answer", zap.Error(err))
 
 		switch err {
-		case constant.ErrSubmissionNotFound, constant.ErrModuleNotFound, constant.ErrQuestionNotFound, constant.ErrChoiceNotFound:
+		case constant.ErrSubmissionNotFound, constant.ErrModuleNotFound, constant.ErrQuestionNotFound, constant.ErrChoiceNotFound, constant.ErrPairNotFound:
 			c.JSON(http.StatusNotFound, format.NotFound(err.Error()))
 			return
-		case constant.ErrSubmissionAlreadyDone:
+		case constant.ErrSubmissionAlreadyDone, constant.ErrInvalidAnswerFormat, constant.ErrIncompletePairs, constant.ErrInvalidNumber, constant.ErrIncompleteOrder:
 			c.JSON(http.StatusBadRequest, format.BadRequest(err.Error(), nil))
 			return
 		case constant.ErrDuplicateAnswer:
 			c.JSON(http.StatusConflict, format.BadRequest(err.Error(), nil))
 			return
+		case constant.ErrModuleClosed, constant.ErrSubmissionExpired:
+			c.JSON(http.StatusForbidden, format.Forbidden(err.Error()))
+			return
 		default:
 			c.JSON(http.StatusInternalServerError, format.InternalServerError())
 			return
@@ -118,6 +142,14 @@ func (h *SubmissionHandler) SubmitAnswer(c *gin.Context) {
 }
 
 func (h *SubmissionHandler) FinalizeSubmission(c *gin.Context) {
+	h.finalizeSubmission(c, submission.NewModuleACLAdapter(h.db, newFileStorage()))
+}
+
+func (h *SubmissionHandler) FinalizePreview(c *gin.Context) {
+	h.finalizeSubmission(c, h.previewModuleACL(c))
+}
+
+func (h *SubmissionHandler) finalizeSubmission(c *gin.Context, moduleACL repository.ModuleACL) {
 	command := service.FinalizeSubmissionCommand{
 		ModuleSlug:     c.Param("module_slug"),
 		SubmissionCode: c.Param("submission_code"),
@@ -131,7 +163,7 @@ func (h *SubmissionHandler) FinalizeSubmission(c *gin.Context) {
 
 	svc := service.NewFinalizeSubmission(
 		submission.NewSubmissionReaderRepository(h.db),
-		submission.NewModuleACLAdapter(h.db),
+		moduleACL,
 		submission.NewUnitOfWork(h.db),
 	)
 
@@ -155,6 +187,98 @@ func (h *SubmissionHandler) FinalizeSubmission(c *gin.Context) {
 	c.JSON(http.StatusOK, format.SuccessOK("submission finalized successfully", result))
 }
 
+func (h *SubmissionHandler) ResumeSubmission(c *gin.Context) {
+	h.resumeSubmission(c, submission.NewModuleACLAdapter(h.db, newFileStorage()))
+}
+
+func (h *SubmissionHandler) ResumePreview(c *gin.Context) {
+	h.resumeSubmission(c, h.previewModuleACL(c))
+}
+
+func (h *SubmissionHandler) resumeSubmission(c *gin.Context, moduleACL repository.ModuleACL) {
+	command := service.ResumeSubmissionCommand{
+		ModuleSlug:     c.Param("module_slug"),
+		SubmissionCode: c.Param("submission_code"),
+	}
+
+	verrs := h.vld.Validate(command)
+	if verrs != nil {
+		c.JSON(http.StatusBadRequest, format.BadRequest("invalid request", verrs))
+		return
+	}
+
+	svc := service.NewResumeSubmission(
+		submission.NewSubmissionReaderRepository(h.db),
+		moduleACL,
+	)
+
+	result, err := svc.Execute(c.Request.Context(), &command)
+	if err != nil {
+		h.logger.Error("failed to resume submission", zap.Error(err))
+
+		switch err {
+		case constant.ErrSubmissionNotFound, constant.ErrModuleNotFound:
+			c.JSON(http.StatusNotFound, format.NotFound(err.Error()))
+			return
+		default:
+			c.JSON(http.StatusInternalServerError, format.InternalServerError())
+			return
+		}
+	}
+
+	c.JSON(http.StatusOK, format.SuccessOK("submission retrieved successfully", result))
+}
+
+func (h *SubmissionHandler) ReviewSubmission(c *gin.Context) {
+	h.reviewSubmission(c, submission.NewModuleACLAdapter(h.db, newFileStorage()))
+}
+
+func (h *SubmissionHandler) ReviewPreview(c *gin.Context) {
+	h.reviewSubmission(c, h.previewModuleACL(c))
+}
+
+func (h *SubmissionHandler) reviewSubmission(c *gin.Context, moduleACL repository.ModuleACL) {
+	command := service.ReviewSubmissionCommand{
+		ModuleSlug:     c.Param("module_slug"),
+		SubmissionCode: c.Param("submission_code"),
+	}
+
+	verrs := h.vld.Validate(command)
+	if verrs != nil {
+		c.JSON(http.StatusBadRequest, format.BadRequest("invalid request", verrs))
+		return
+	}
+
+	svc := service.NewReviewSubmission(
+		submission.NewSubmissionReaderRepository(h.db),
+		moduleACL,
+	)
+
+	result, err := svc.Execute(c.Request.Context(), &command)
+	if err != nil {
+		h.logger.Error("failed to review submission", zap.Error(err))
+
+		switch err {
+		case constant.ErrSubmissionNotFound, constant.ErrModuleNotFound, constant.ErrQuestionNotFound:
+			c.JSON(http.StatusNotFound, format.NotFound(err.Error()))
+			return
+		case constant.ErrSubmissionNotDone:
+			c.JSON(http.StatusBadRequest, format.BadRequest(err.Error(), nil))
+			return
+		default:
+			c.JSON(http.StatusInternalServerError, format.InternalServerError())
+			return
+		}
+	}
+
+	c.JSON(http.StatusOK, format.SuccessOK("submission review retrieved successfully", result))
+}
+
+// previewModuleACL serves the authenticated teacher's own modules from their draft
+func (h *SubmissionHandler) previewModuleACL(c *gin.Context) repository.ModuleACL {
+	return submission.NewPreviewModuleACLAdapter(h.db, newFileStorage(), shared.NewAuthStorage(c).GetUserId())
+}
+
 func (h *SubmissionHandler) GetAllSubmissions(c *gin.Context) {
 	var query service.FindAllSubmissionQuery
 
@@ -166,7 +290,7 @@ func (h *SubmissionHandler) GetAllSubmissions(c *gin.Context) {
 
 	svc := service.NewFindAllSubmission(
 		submission.NewSubmissionReaderRepository(h.db),
-		submission.NewModuleACLAdapter(h.db),
+		submission.NewModuleACLAdapter(h.db, newFileStorage()),
 	)
 
 	result, err := svc.Execute(c.Request.Context(), &query)
@@ -0,0 +1,24 @@
+package attachment
+
+import (
+	"github.com/arvinpaundra/private-api/application/rest/handler"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+type AttachmentRouter struct {
+	logger *zap.Logger
+}
+
+func NewAttachmentRouter(logger *zap.Logger) *AttachmentRouter {
+	return &AttachmentRouter{
+		logger: logger,
+	}
+}
+
+func (r *AttachmentRouter) Public(g *gin.RouterGroup) {
+	h := handler.NewAttachmentHandler(r.logger)
+
+	// Signed links of the local storage driver, see STORAGE_LOCAL_URL
+	g.GET("/attachments/*key", h.ServeAttachment)
+}
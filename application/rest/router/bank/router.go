@@ -0,0 +1,42 @@
+package bank
+
+import (
+	"github.com/arvinpaundra/private-api/application/rest/handler"
+	"github.com/arvinpaundra/private-api/application/rest/middleware"
+	"github.com/arvinpaundra/private-api/core/validator"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+type BankRouter struct {
+	db     *gorm.DB
+	logger *zap.Logger
+	vld    *validator.Validator
+}
+
+func NewBankRouter(
+	db *gorm.DB,
+	logger *zap.Logger,
+	vld *validator.Validator,
+) *BankRouter {
+	return &BankRouter{
+		db:     db,
+		logger: logger,
+		vld:    vld,
+	}
+}
+
+func (r *BankRouter) Private(g *gin.RouterGroup) {
+	h := handler.NewBankHandler(r.db, r.logger, r.vld)
+	m := middleware.NewAuthenticate(r.db)
+
+	question := g.Group("/bank/questions", m.Authenticate())
+	{
+		question.POST("", h.CreateBankQuestion)
+		question.PUT("/:id", h.UpdateBankQuestion)
+		question.DELETE("/:id", h.DeleteBankQuestion)
+		question.GET("", h.FindAllBankQuestions)
+		question.GET("/:id", h.FindDetailBankQuestion)
+	}
+}
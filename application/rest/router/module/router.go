@@ -35,18 +35,33 @@ func (r *ModuleRouter) Private(g *gin.RouterGroup) {
 	{
 		module.POST("", h.CreateModule)
 		module.GET("", h.FindAllModules)
+		module.POST("/import", h.ImportModule)
+		module.POST("/import/qti", h.ImportPackage)
 	}
 
 	moduleDetail := module.Group("/:module_slug")
 	{
 		moduleDetail.GET("", h.FindDetailModule)
+		moduleDetail.PUT("", h.UpdateModule)
+		moduleDetail.PUT("/schedule", h.UpdateModuleSchedule)
 		moduleDetail.DELETE("", h.DeleteModule)
 		moduleDetail.GET("/questions", h.FindDetailModuleQuestions)
 		moduleDetail.PATCH("/publish", h.TogglePublishModule)
+		moduleDetail.POST("/versions", h.PublishModuleVersion)
+		moduleDetail.POST("/clone", h.CloneModule)
+		moduleDetail.GET("/export", h.ExportModule)
+		moduleDetail.GET("/preview/:submission_code/questions/:question_slug", h.FindPreviewQuestion)
 
 		question := moduleDetail.Group("/questions")
 
 		question.POST("", h.AddQuestions)
+		question.POST("/bank", h.AddBankQuestions)
+		question.POST("/import", h.ImportQuestions)
+		question.PATCH("/order", h.ReorderQuestions)
+		question.PUT("/:question_id", h.UpdateQuestion)
+		question.DELETE("/:question_id", h.DeleteQuestion)
+		question.POST("/:question_id/attachments", h.UploadAttachment)
+		question.DELETE("/:question_id/attachments/:attachment_id", h.DeleteAttachment)
 	}
 }
 
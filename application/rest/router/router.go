@@ -2,7 +2,9 @@ package router
 
 import (
 	"github.com/arvinpaundra/private-api/application/rest/middleware"
+	"github.com/arvinpaundra/private-api/application/rest/router/attachment"
 	"github.com/arvinpaundra/private-api/application/rest/router/auth"
+	"github.com/arvinpaundra/private-api/application/rest/router/bank"
 	"github.com/arvinpaundra/private-api/application/rest/router/dashboard"
 	"github.com/arvinpaundra/private-api/application/rest/router/grade"
 	"github.com/arvinpaundra/private-api/application/rest/router/health"
@@ -32,19 +34,23 @@ func Register(g *gin.Engine, db *gorm.DB, logger *zap.Logger) *gin.Engine {
 	subjectRouter := subject.NewSubjectRouter(db, logger, validator.NewValidator())
 	gradeRouter := grade.NewGradeRouter(db, logger, validator.NewValidator())
 	moduleRouter := module.NewModuleRouter(db, logger, validator.NewValidator())
+	bankRouter := bank.NewBankRouter(db, logger, validator.NewValidator())
 	submissionRouter := submission.NewSubmissionRouter(db, logger, validator.NewValidator())
 	dashboardRouter := dashboard.NewDashboardRouter(db, logger)
+	attachmentRouter := attachment.NewAttachmentRouter(logger)
 
 	// public routes
 	authRouter.Public(v1)
 	moduleRouter.Public(v1)
 	submissionRouter.Public(v1)
+	attachmentRouter.Public(v1)
 
 	// private routes
 	authRouter.Private(v1)
 	subjectRouter.Private(v1)
 	gradeRouter.Private(v1)
 	moduleRouter.Private(v1)
+	bankRouter.Private(v1)
 	submissionRouter.Private(v1)
 	dashboardRouter.Private(v1)
 
@@ -34,6 +34,15 @@ func (r *SubmissionRouter) Private(g *gin.RouterGroup) {
 	submission := g.Group("/submissions", m.Authenticate())
 
 	submission.GET("", h.GetAllSubmissions)
+
+	preview := g.Group("/modules/:module_slug/preview", m.Authenticate())
+	{
+		preview.POST("", h.StartPreview)
+		preview.GET("/:submission_code", h.ResumePreview)
+		preview.POST("/:submission_code/answers", h.SubmitPreviewAnswer)
+		preview.PATCH("/:submission_code/finalize", h.FinalizePreview)
+		preview.GET("/:submission_code/review", h.ReviewPreview)
+	}
 }
 
 func (r *SubmissionRouter) Public(g *gin.RouterGroup) {
@@ -42,7 +51,9 @@ func (r *SubmissionRouter) Public(g *gin.RouterGroup) {
 	submission := g.Group("/modules/:module_slug/submissions")
 	{
 		submission.POST("", h.StartSubmission)
+		submission.GET("/:submission_code", h.ResumeSubmission)
 		submission.POST("/:submission_code/answers", h.SubmitAnswer)
 		submission.PATCH("/:submission_code/finalize", h.FinalizeSubmission)
+		submission.GET("/:submission_code/review", h.ReviewSubmission)
 	}
 }
@@ -0,0 +1,85 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/arvinpaundra/private-api/domain/submission/service"
+	"github.com/arvinpaundra/private-api/infrastructure/submission"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// Worker runs the background jobs every interval until its context is canceled
+type Worker struct {
+	db       *gorm.DB
+	logger   *zap.Logger
+	interval time.Duration
+}
+
+func NewWorker(
+	db *gorm.DB,
+	logger *zap.Logger,
+	interval time.Duration,
+) *Worker {
+	return &Worker{
+		db:       db,
+		logger:   logger.With(zap.String("domain", "worker")),
+		interval: interval,
+	}
+}
+
+func (w *Worker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		// Overdue submissions expire first, so closing a module never finalizes them as submitted
+		w.expireOverdueSubmissions(ctx)
+		w.finalizeClosedSubmissions(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// expireOverdueSubmissions finalizes the submissions whose time ran out before the student finished
+func (w *Worker) expireOverdueSubmissions(ctx context.Context) {
+	svc := service.NewExpireOverdueSubmissions(
+		submission.NewSubmissionReaderRepository(w.db),
+		submission.NewUnitOfWork(w.db),
+	)
+
+	count, err := svc.Execute(ctx)
+	if err != nil {
+		w.logger.Error("failed to expire overdue submissions", zap.Error(err))
+		return
+	}
+
+	if count > 0 {
+		w.logger.Info("expired overdue submissions", zap.Int("count", count))
+	}
+}
+
+// finalizeClosedSubmissions finalizes the submissions left in progress when their module closed
+func (w *Worker) finalizeClosedSubmissions(ctx context.Context) {
+	svc := service.NewFinalizeClosedSubmissions(
+		submission.NewSubmissionReaderRepository(w.db),
+		// closed modules are looked up without serving any question, so no file storage is needed
+		submission.NewModuleACLAdapter(w.db, nil),
+		submission.NewUnitOfWork(w.db),
+	)
+
+	count, err := svc.Execute(ctx)
+	if err != nil {
+		w.logger.Error("failed to finalize closed submissions", zap.Error(err))
+		return
+	}
+
+	if count > 0 {
+		w.logger.Info("finalized closed submissions", zap.Int("count", count))
+	}
+}
@@ -0,0 +1,64 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/arvinpaundra/private-api/application/worker"
+	"github.com/arvinpaundra/private-api/config"
+	"github.com/arvinpaundra/private-api/core/util"
+	"github.com/arvinpaundra/private-api/database/relationaldb"
+	"github.com/spf13/cobra"
+)
+
+var interval time.Duration
+
+var workerCmd = &cobra.Command{
+	Use:   "worker",
+	Short: "Start background worker",
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		// the jobs are run on a ticker, which needs a positive interval
+		if interval <= 0 {
+			return errors.New("--interval must be greater than 0")
+		}
+
+		return nil
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		config.LoadEnv(".", ".env", "env")
+
+		relationaldb.NewConnection(relationaldb.NewPostgres())
+
+		ctx, cancel := context.WithCancel(context.Background())
+
+		w := worker.NewWorker(relationaldb.GetConnection(), util.NewLogger(config.GetString("APP_ENV")), interval)
+
+		done := make(chan struct{})
+
+		go func() {
+			log.Println("Starting worker...")
+			w.Run(ctx)
+			close(done)
+		}()
+
+		wait := util.GracefulShutdown(context.Background(), 30*time.Second, map[string]func(ctx context.Context) error{
+			"worker": func(_ context.Context) error {
+				cancel()
+				<-done
+				return nil
+			},
+			"postgres": func(_ context.Context) error {
+				return relationaldb.Close()
+			},
+		})
+
+		<-wait
+	},
+}
+
+func init() {
+	workerCmd.Flags().DurationVarP(&interval, "interval", "i", time.Minute, "how often the background jobs run")
+	rootCmd.AddCommand(workerCmd)
+}
@@ -0,0 +1,116 @@
+package moodle
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	aikenChoicePattern = regexp.MustCompile(`^([A-Z])[.)]\s+(.+)$`)
+	aikenAnswerPattern = regexp.MustCompile(`^ANSWER:\s*(.*)$`)
+)
+
+// ParseAiken reads the single choice questions of an Aiken file:
+// the question, choices labelled "A." or "A)" and an "ANSWER: A" line.
+// A question with a line that does not fit is left out and the line reported.
+func ParseAiken(text string) ([]*Question, []*LineError) {
+	errs := make(lineErrors)
+
+	var (
+		questions []*Question
+		current   *Question
+		invalid   bool
+	)
+
+	for i, raw := range strings.Split(normalizeNewlines(text), "\n") {
+		line := strings.TrimSpace(raw)
+		number := i + 1
+
+		if line == "" {
+			continue
+		}
+
+		if current == nil {
+			current = &Question{Line: number, Type: MultipleChoice, Text: line}
+			invalid = false
+			continue
+		}
+
+		if match := aikenAnswerPattern.FindStringSubmatch(line); match != nil {
+			answer := strings.TrimSpace(match[1])
+
+			index := -1
+			if len(answer) == 1 {
+				index = int(answer[0]) - 'A'
+			}
+
+			if index < 0 || index >= len(current.Choices) {
+				errs.add(number, "ANSWER %q does not name one of the choices", answer)
+				invalid = true
+			} else {
+				current.Choices[index].Correct = true
+			}
+
+			if !invalid {
+				questions = append(questions, current)
+			}
+
+			current = nil
+			continue
+		}
+
+		if match := aikenChoicePattern.FindStringSubmatch(line); match != nil {
+			expected := byte('A' + len(current.Choices))
+			if match[1][0] != expected {
+				errs.add(number, "choice %s should be labelled %c", match[1], expected)
+				invalid = true
+			}
+
+			current.Choices = append(current.Choices, &Choice{Text: strings.TrimSpace(match[2])})
+			continue
+		}
+
+		// Text before the first choice continues the question
+		if len(current.Choices) == 0 {
+			current.Text += "\n" + line
+			continue
+		}
+
+		errs.add(number, "expected a choice such as \"%c. text\" or the ANSWER line", 'A'+len(current.Choices))
+		invalid = true
+	}
+
+	if current != nil {
+		errs.add(current.Line, "question has no ANSWER line")
+	}
+
+	return questions, errs.list()
+}
+
+// WriteAiken renders single choice questions as an Aiken file, each question and choice on one line
+func WriteAiken(questions []*Question) string {
+	var result strings.Builder
+
+	for i, question := range questions {
+		if i > 0 {
+			result.WriteString("\n")
+		}
+
+		result.WriteString(question.Text + "\n")
+
+		answer := ""
+		for j, choice := range question.Choices {
+			label := string(rune('A' + j))
+
+			result.WriteString(label + ". " + choice.Text + "\n")
+
+			if choice.Correct && answer == "" {
+				answer = label
+			}
+		}
+
+		result.WriteString("ANSWER: " + answer + "\n")
+	}
+
+	return result.String()
+}
@@ -0,0 +1,73 @@
+package moodle
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseAiken(t *testing.T) {
+	text := "What is 2 + 2?\r\n" +
+		"A. 3\r\n" +
+		"B) 4\r\n" +
+		"ANSWER: B\r\n" +
+		"\r\n" +
+		"Which is a colour?\n" +
+		"A. Red\n" +
+		"C. Table\n" +
+		"ANSWER: A\n" +
+		"\n" +
+		"Capital of Italy?\n" +
+		"A. Rome\n" +
+		"B. Milan\n" +
+		"ANSWER: E\n" +
+		"\n" +
+		"Largest planet?\n" +
+		"A. Jupiter\n" +
+		"B. Mars\n"
+
+	questions, errs := ParseAiken(text)
+
+	want := []*Question{
+		{Line: 1, Type: MultipleChoice, Text: "What is 2 + 2?", Choices: []*Choice{{Text: "3"}, {Text: "4", Correct: true}}},
+	}
+
+	if !reflect.DeepEqual(questions, want) {
+		t.Errorf("ParseAiken() = %+v, want %+v", questions, want)
+	}
+
+	var lines []int
+	for _, err := range errs {
+		lines = append(lines, err.Line)
+	}
+
+	if want := []int{8, 14, 16}; !reflect.DeepEqual(lines, want) {
+		t.Errorf("ParseAiken() error lines = %v, want %v (%v)", lines, want, errs)
+	}
+}
+
+func TestWriteAiken(t *testing.T) {
+	questions := []*Question{
+		{Type: MultipleChoice, Text: "What is 2 + 2?", Choices: []*Choice{{Text: "3"}, {Text: "4", Correct: true}}},
+		{Type: MultipleChoice, Text: "Pick", Choices: []*Choice{{Text: "a", Correct: true}, {Text: "b"}}},
+	}
+
+	text := WriteAiken(questions)
+
+	want := "What is 2 + 2?\nA. 3\nB. 4\nANSWER: B\n\nPick\nA. a\nB. b\nANSWER: A\n"
+	if text != want {
+		t.Errorf("WriteAiken() = %q, want %q", text, want)
+	}
+
+	parsed, errs := ParseAiken(text)
+	if len(errs) != 0 {
+		t.Fatalf("ParseAiken() errors = %v", errs)
+	}
+
+	for i := range questions {
+		parsed[i].Line = 0
+
+		if !reflect.DeepEqual(parsed[i], questions[i]) {
+			t.Errorf("question %d read back as %+v, want %+v", i, parsed[i], questions[i])
+		}
+	}
+}
@@ -0,0 +1,591 @@
+package moodle
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// giftSpecial are the characters GIFT gives a meaning to, written with a backslash to appear in text
+const giftSpecial = `~=#{}:`
+
+type giftAnswer struct {
+	marker      byte // '=' or '~'
+	weight      *float64
+	text        string
+	feedback    string
+	hasFeedback bool
+}
+
+// ParseGIFT reads the questions of a GIFT file. Question titles, comments and categories are ignored.
+// A question using a construct that cannot be represented is left out and reported at the line it starts on.
+func ParseGIFT(text string) ([]*Question, []*LineError) {
+	lines := strings.Split(normalizeNewlines(text), "\n")
+	errs := make(lineErrors)
+
+	var (
+		questions []*Question
+		block     []string
+		start     int
+	)
+
+	flush := func() {
+		if len(block) == 0 {
+			return
+		}
+
+		question := parseGIFTQuestion(strings.Join(block, "\n"), start, errs)
+		if question != nil {
+			questions = append(questions, question)
+		}
+
+		block = nil
+	}
+
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+
+		switch {
+		case trimmed == "":
+			// questions are separated by blank lines
+			flush()
+		case strings.HasPrefix(trimmed, "//"):
+			continue
+		case len(block) == 0 && strings.HasPrefix(trimmed, "$CATEGORY:"):
+			continue
+		default:
+			if len(block) == 0 {
+				start = i + 1
+			}
+
+			block = append(block, line)
+		}
+	}
+
+	flush()
+
+	return questions, errs.list()
+}
+
+func parseGIFTQuestion(raw string, line int, errs lineErrors) *Question {
+	question := &Question{Line: line}
+
+	rest := strings.TrimSpace(raw)
+
+	if strings.HasPrefix(rest, "::") {
+		end := indexUnescaped(rest[2:], "::")
+		if end < 0 {
+			errs.add(line, "question title is not closed with ::")
+			return nil
+		}
+
+		rest = strings.TrimSpace(rest[2+end+2:])
+	}
+
+	if strings.HasPrefix(rest, "[") {
+		if end := strings.Index(rest, "]"); end > 0 {
+			switch strings.ToLower(rest[1:end]) {
+			case "markdown":
+				question.Markdown = true
+				rest = rest[end+1:]
+			case "plain", "moodle":
+				rest = rest[end+1:]
+			case "html":
+				errs.add(line, "html text format is not supported, use markdown or plain")
+				return nil
+			}
+		}
+	}
+
+	open := indexUnescaped(rest, "{")
+	if open < 0 {
+		errs.add(line, "descriptions without an answer block are not supported")
+		return nil
+	}
+
+	closing := indexUnescaped(rest[open:], "}")
+	if closing < 0 {
+		errs.add(line, "answer block is not closed with }")
+		return nil
+	}
+
+	closing += open
+
+	before, body, after := rest[:open], rest[open+1:closing], rest[closing+1:]
+
+	if indexUnescaped(after, "{") >= 0 {
+		errs.add(line, "questions with several answer blocks are not supported")
+		return nil
+	}
+
+	// A block inside the text is a missing word question, the gap is kept as a blank
+	text := before
+	if strings.TrimSpace(after) != "" {
+		text = before + "_____" + after
+	}
+
+	question.Text = unescapeGIFT(strings.TrimSpace(text))
+	if question.Text == "" {
+		errs.add(line, "question text is empty")
+		return nil
+	}
+
+	if i := indexUnescaped(body, "####"); i >= 0 {
+		question.Feedback = unescapeGIFT(strings.TrimSpace(body[i+4:]))
+		body = body[:i]
+	}
+
+	body = strings.TrimSpace(body)
+
+	var ok bool
+
+	switch {
+	case body == "":
+		errs.add(line, "essay questions are not supported")
+	case strings.HasPrefix(body, "#"):
+		ok = parseGIFTNumerical(question, body[1:], errs)
+	case isGIFTTrueFalse(body):
+		ok = parseGIFTTrueFalse(question, body, errs)
+	default:
+		ok = parseGIFTAnswers(question, body, errs)
+	}
+
+	if !ok {
+		return nil
+	}
+
+	return question
+}
+
+func isGIFTTrueFalse(body string) bool {
+	value := body
+	if i := indexUnescaped(body, "#"); i >= 0 {
+		value = body[:i]
+	}
+
+	switch strings.ToUpper(strings.TrimSpace(value)) {
+	case "T", "TRUE", "F", "FALSE":
+		return true
+	default:
+		return false
+	}
+}
+
+// parseGIFTTrueFalse turns {T#wrong feedback#right feedback} into a True / False choice
+func parseGIFTTrueFalse(question *Question, body string, errs lineErrors) bool {
+	parts := splitUnescaped(body, '#')
+	if len(parts) > 3 {
+		errs.add(question.Line, "true/false answer has more than two feedbacks")
+		return false
+	}
+
+	for len(parts) < 3 {
+		parts = append(parts, "")
+	}
+
+	value := strings.ToUpper(strings.TrimSpace(parts[0]))
+	isTrue := value == "T" || value == "TRUE"
+
+	wrongFeedback := unescapeGIFT(strings.TrimSpace(parts[1]))
+	rightFeedback := unescapeGIFT(strings.TrimSpace(parts[2]))
+
+	trueChoice := &Choice{Text: "True", Correct: isTrue}
+	falseChoice := &Choice{Text: "False", Correct: !isTrue}
+
+	if isTrue {
+		trueChoice.Feedback, falseChoice.Feedback = rightFeedback, wrongFeedback
+	} else {
+		trueChoice.Feedback, falseChoice.Feedback = wrongFeedback, rightFeedback
+	}
+
+	question.Type = MultipleChoice
+	question.Choices = []*Choice{trueChoice, falseChoice}
+
+	return true
+}
+
+// parseGIFTNumerical reads {#value:tolerance}, {#min..max} or a single {#=value:tolerance} answer
+func parseGIFTNumerical(question *Question, body string, errs lineErrors) bool {
+	body = strings.TrimSpace(body)
+
+	if strings.HasPrefix(body, "=") || strings.HasPrefix(body, "~") {
+		leading, answers := splitGIFTAnswers(body)
+		if strings.TrimSpace(leading) != "" || len(answers) != 1 || answers[0].marker != '=' ||
+			(answers[0].weight != nil && *answers[0].weight != 100) {
+			errs.add(question.Line, "numerical questions with several answers or partial credit are not supported")
+			return false
+		}
+
+		if answers[0].hasFeedback {
+			errs.add(question.Line, "feedback on numerical answers is not supported")
+			return false
+		}
+
+		body = answers[0].text
+	} else if indexUnescaped(body, "#") >= 0 {
+		errs.add(question.Line, "feedback on numerical answers is not supported")
+		return false
+	}
+
+	body = unescapeGIFT(strings.TrimSpace(body))
+
+	if from, to, ok := strings.Cut(body, ".."); ok {
+		low, lowErr := strconv.ParseFloat(strings.TrimSpace(from), 64)
+		high, highErr := strconv.ParseFloat(strings.TrimSpace(to), 64)
+		if lowErr != nil || highErr != nil || low > high {
+			errs.add(question.Line, "numerical range %q is not valid", body)
+			return false
+		}
+
+		question.Value = (low + high) / 2
+		question.Tolerance = (high - low) / 2
+	} else {
+		value, tolerance, _ := strings.Cut(body, ":")
+
+		number, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil {
+			errs.add(question.Line, "numerical answer %q is not a number", value)
+			return false
+		}
+
+		question.Value = number
+
+		if tolerance != "" {
+			margin, err := strconv.ParseFloat(strings.TrimSpace(tolerance), 64)
+			if err != nil || margin < 0 {
+				errs.add(question.Line, "numerical tolerance %q is not a positive number", tolerance)
+				return false
+			}
+
+			question.Tolerance = margin
+		}
+	}
+
+	question.Type = Numerical
+
+	return true
+}
+
+// parseGIFTAnswers reads a list of =right and ~wrong answers as a choice, short answer or matching question
+func parseGIFTAnswers(question *Question, body string, errs lineErrors) bool {
+	leading, answers := splitGIFTAnswers(body)
+	if strings.TrimSpace(leading) != "" || len(answers) == 0 {
+		errs.add(question.Line, "answers must start with = or ~")
+		return false
+	}
+
+	var wrong, pairs int
+	for _, answer := range answers {
+		if answer.marker == '~' {
+			wrong++
+		}
+
+		if indexUnescaped(answer.text, "->") >= 0 {
+			pairs++
+		}
+	}
+
+	switch {
+	case pairs > 0:
+		if pairs != len(answers) || wrong > 0 {
+			errs.add(question.Line, "every matching answer must be written =left -> right")
+			return false
+		}
+
+		for _, answer := range answers {
+			if answer.weight != nil || answer.hasFeedback {
+				errs.add(question.Line, "weights and feedback on matching answers are not supported")
+				return false
+			}
+
+			i := indexUnescaped(answer.text, "->")
+			question.Pairs = append(question.Pairs, &Pair{
+				Left:  unescapeGIFT(strings.TrimSpace(answer.text[:i])),
+				Right: unescapeGIFT(strings.TrimSpace(answer.text[i+2:])),
+			})
+		}
+
+		question.Type = Matching
+	case wrong == 0:
+		for _, answer := range answers {
+			if answer.weight != nil && *answer.weight != 100 {
+				errs.add(question.Line, "partial credit on short answers is not supported")
+				return false
+			}
+
+			if answer.hasFeedback {
+				errs.add(question.Line, "feedback on short answers is not supported")
+				return false
+			}
+
+			question.Answers = append(question.Answers, unescapeGIFT(strings.TrimSpace(answer.text)))
+		}
+
+		question.Type = ShortAnswer
+	default:
+		var full, partial int
+
+		for _, answer := range answers {
+			correct := answer.marker == '='
+			if answer.weight != nil {
+				correct = *answer.weight > 0
+
+				if correct && *answer.weight < 100 {
+					partial++
+				}
+			}
+
+			if correct && (answer.weight == nil || *answer.weight >= 100) {
+				full++
+			}
+
+			question.Choices = append(question.Choices, &Choice{
+				Text:     unescapeGIFT(strings.TrimSpace(answer.text)),
+				Correct:  correct,
+				Feedback: unescapeGIFT(strings.TrimSpace(answer.feedback)),
+			})
+		}
+
+		// Weights that add up over several choices mean a multiple response question, anything else is partial credit
+		if partial > 0 && full > 0 {
+			errs.add(question.Line, "partial credit is not supported, a choice is either right or wrong")
+			return false
+		}
+
+		question.Type = MultipleChoice
+		if partial > 0 || full > 1 {
+			question.Type = MultipleResponse
+		}
+	}
+
+	return true
+}
+
+// splitGIFTAnswers cuts the answer block at every unescaped = or ~, returning the text before the first one
+func splitGIFTAnswers(body string) (string, []*giftAnswer) {
+	var (
+		answers []*giftAnswer
+		start   = -1
+		leading = body
+	)
+
+	add := func(end int) {
+		if start < 0 {
+			leading = body[:end]
+			return
+		}
+
+		answers = append(answers, newGIFTAnswer(body[start], body[start+1:end]))
+	}
+
+	for i := 0; i < len(body); i++ {
+		switch body[i] {
+		case '\\':
+			i++
+		case '=', '~':
+			add(i)
+			start = i
+		}
+	}
+
+	add(len(body))
+
+	return leading, answers
+}
+
+func newGIFTAnswer(marker byte, raw string) *giftAnswer {
+	answer := &giftAnswer{marker: marker}
+
+	raw = strings.TrimSpace(raw)
+
+	if strings.HasPrefix(raw, "%") {
+		if end := strings.Index(raw[1:], "%"); end >= 0 {
+			if weight, err := strconv.ParseFloat(raw[1:end+1], 64); err == nil {
+				answer.weight = &weight
+				raw = raw[end+2:]
+			}
+		}
+	}
+
+	if i := indexUnescaped(raw, "#"); i >= 0 {
+		answer.feedback = raw[i+1:]
+		answer.hasFeedback = true
+		raw = raw[:i]
+	}
+
+	answer.text = raw
+
+	return answer
+}
+
+// WriteGIFT renders the questions as a GIFT file, one paragraph per question
+func WriteGIFT(questions []*Question) string {
+	var result strings.Builder
+
+	for i, question := range questions {
+		if i > 0 {
+			result.WriteString("\n")
+		}
+
+		if question.Markdown {
+			result.WriteString("[markdown]")
+		}
+
+		result.WriteString(escapeGIFT(question.Text))
+		result.WriteString(" {")
+
+		if question.Type == Numerical {
+			result.WriteString("#")
+		}
+
+		result.WriteString("\n")
+
+		switch question.Type {
+		case MultipleChoice, MultipleResponse:
+			writeGIFTChoices(&result, question)
+		case ShortAnswer:
+			for _, answer := range question.Answers {
+				result.WriteString("\t=" + escapeGIFT(answer) + "\n")
+			}
+		case Numerical:
+			result.WriteString("\t=" + formatNumber(question.Value))
+			if question.Tolerance > 0 {
+				result.WriteString(":" + formatNumber(question.Tolerance))
+			}
+			result.WriteString("\n")
+		case Matching:
+			for _, pair := range question.Pairs {
+				result.WriteString("\t=" + escapeGIFT(pair.Left) + " -> " + escapeGIFT(pair.Right) + "\n")
+			}
+		}
+
+		if question.Feedback != "" {
+			result.WriteString("\t####" + escapeGIFT(question.Feedback) + "\n")
+		}
+
+		result.WriteString("}\n")
+	}
+
+	return result.String()
+}
+
+func writeGIFTChoices(result *strings.Builder, question *Question) {
+	correct := 0
+	for _, choice := range question.Choices {
+		if choice.Correct {
+			correct++
+		}
+	}
+
+	for _, choice := range question.Choices {
+		switch {
+		case question.Type == MultipleChoice && choice.Correct:
+			result.WriteString("\t=")
+		case question.Type == MultipleChoice:
+			result.WriteString("\t~")
+		case choice.Correct:
+			// the correct choices share the full mark, Moodle reads the weights to five decimals
+			result.WriteString("\t~%" + formatNumber(math.Round(100/float64(correct)*1e5)/1e5) + "%")
+		default:
+			result.WriteString("\t~%-100%")
+		}
+
+		result.WriteString(escapeGIFT(choice.Text))
+
+		if choice.Feedback != "" {
+			result.WriteString("#" + escapeGIFT(choice.Feedback))
+		}
+
+		result.WriteString("\n")
+	}
+}
+
+func formatNumber(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
+
+func escapeGIFT(text string) string {
+	var result strings.Builder
+
+	for _, r := range text {
+		switch {
+		case r == '\n':
+			result.WriteString(`\n`)
+		case r == '\\' || strings.ContainsRune(giftSpecial, r):
+			result.WriteRune('\\')
+			result.WriteRune(r)
+		default:
+			result.WriteRune(r)
+		}
+	}
+
+	return result.String()
+}
+
+func unescapeGIFT(text string) string {
+	var result strings.Builder
+
+	for i := 0; i < len(text); i++ {
+		if text[i] != '\\' || i+1 == len(text) {
+			result.WriteByte(text[i])
+			continue
+		}
+
+		next := text[i+1]
+
+		switch {
+		case next == 'n':
+			result.WriteByte('\n')
+		case next == '\\' || strings.IndexByte(giftSpecial, next) >= 0:
+			result.WriteByte(next)
+		default:
+			// other backslashes, such as LaTeX commands, are kept as written
+			result.WriteByte('\\')
+			result.WriteByte(next)
+		}
+
+		i++
+	}
+
+	return result.String()
+}
+
+// indexUnescaped is strings.Index skipping matches preceded by a backslash
+func indexUnescaped(text, substr string) int {
+	for i := 0; i < len(text); i++ {
+		if text[i] == '\\' {
+			i++
+			continue
+		}
+
+		if strings.HasPrefix(text[i:], substr) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func splitUnescaped(text string, separator byte) []string {
+	var parts []string
+
+	start := 0
+	for i := 0; i < len(text); i++ {
+		switch text[i] {
+		case '\\':
+			i++
+		case separator:
+			parts = append(parts, text[start:i])
+			start = i + 1
+		}
+	}
+
+	return append(parts, text[start:])
+}
+
+func normalizeNewlines(text string) string {
+	text = strings.TrimPrefix(text, "\ufeff")
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+
+	return strings.ReplaceAll(text, "\r", "\n")
+}
@@ -0,0 +1,133 @@
+package moodle
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseGIFT(t *testing.T) {
+	text := "// a comment\n" +
+		"$CATEGORY: $course$/Maths\n" +
+		"\n" +
+		"::Q1:: What is 2 + 2? {\n" +
+		"\t=4#Well done\n" +
+		"\t~3#Count again\n" +
+		"\t####Two and two make four.\n" +
+		"}\n" +
+		"\n" +
+		"[markdown]The **sun** is a star.{T}\n" +
+		"\n" +
+		"Pick the primes {~%50%2 ~%50%3 ~%-100%4}\n" +
+		"\n" +
+		"The capital of France is {=Paris =paris} city.\n" +
+		"\n" +
+		"What is \\{pi\\}? {#3.14:0.01}\n" +
+		"\n" +
+		"Between {#1..5}\n" +
+		"\n" +
+		"Match {=cat -> meow =dog -> woof}\n"
+
+	questions, errs := ParseGIFT(text)
+	if len(errs) != 0 {
+		t.Fatalf("ParseGIFT() errors = %v", errs)
+	}
+
+	want := []*Question{
+		{
+			Line: 4, Type: MultipleChoice, Text: "What is 2 + 2?", Feedback: "Two and two make four.",
+			Choices: []*Choice{{Text: "4", Correct: true, Feedback: "Well done"}, {Text: "3", Feedback: "Count again"}},
+		},
+		{
+			Line: 10, Type: MultipleChoice, Text: "The **sun** is a star.", Markdown: true,
+			Choices: []*Choice{{Text: "True", Correct: true}, {Text: "False"}},
+		},
+		{
+			Line: 12, Type: MultipleResponse, Text: "Pick the primes",
+			Choices: []*Choice{{Text: "2", Correct: true}, {Text: "3", Correct: true}, {Text: "4"}},
+		},
+		{Line: 14, Type: ShortAnswer, Text: "The capital of France is _____ city.", Answers: []string{"Paris", "paris"}},
+		{Line: 16, Type: Numerical, Text: "What is {pi}?", Value: 3.14, Tolerance: 0.01},
+		{Line: 18, Type: Numerical, Text: "Between", Value: 3, Tolerance: 2},
+		{Line: 20, Type: Matching, Text: "Match", Pairs: []*Pair{{Left: "cat", Right: "meow"}, {Left: "dog", Right: "woof"}}},
+	}
+
+	if len(questions) != len(want) {
+		t.Fatalf("ParseGIFT() returned %d questions, want %d", len(questions), len(want))
+	}
+
+	for i := range want {
+		if !reflect.DeepEqual(questions[i], want[i]) {
+			t.Errorf("question %d = %+v, want %+v", i, questions[i], want[i])
+		}
+	}
+}
+
+func TestParseGIFT_Unsupported(t *testing.T) {
+	text := "Write an essay {}\n" +
+		"\n" +
+		"Just a description\n" +
+		"\n" +
+		"<b>Bold</b> [html]{=a ~b}\n" +
+		"\n" +
+		"[html]<b>Bold</b> {=a ~b}\n" +
+		"\n" +
+		"Partial {=a ~%50%b ~c}\n" +
+		"\n" +
+		"Fine {=a ~b}\n" +
+		"\n" +
+		"Two {=a} blocks {=b}\n"
+
+	questions, errs := ParseGIFT(text)
+
+	if len(questions) != 2 {
+		t.Errorf("ParseGIFT() returned %d questions, want 2", len(questions))
+	}
+
+	var lines []int
+	for _, err := range errs {
+		lines = append(lines, err.Line)
+	}
+
+	if want := []int{1, 3, 7, 9, 13}; !reflect.DeepEqual(lines, want) {
+		t.Errorf("ParseGIFT() error lines = %v, want %v (%v)", lines, want, errs)
+	}
+}
+
+func TestWriteGIFT(t *testing.T) {
+	questions := []*Question{
+		{
+			Type: MultipleChoice, Text: "Is 1 = 1?\nReally?", Feedback: "By definition.",
+			Choices: []*Choice{{Text: "Yes", Correct: true, Feedback: "Right: it is"}, {Text: "No"}},
+		},
+		{Type: MultipleResponse, Text: "Pick", Markdown: true, Choices: []*Choice{{Text: "a", Correct: true}, {Text: "b", Correct: true}, {Text: "c", Correct: true}, {Text: "d"}}},
+		{Type: ShortAnswer, Text: `Say $\frac{1}{2}$`, Answers: []string{"half"}},
+		{Type: Numerical, Text: "Pi", Value: 3.14, Tolerance: 0.01},
+		{Type: Matching, Text: "Match", Pairs: []*Pair{{Left: "a", Right: "1"}, {Left: "b", Right: "2"}}},
+	}
+
+	text := WriteGIFT(questions)
+
+	want := "Is 1 \\= 1?\\nReally? {\n\t=Yes#Right\\: it is\n\t~No\n\t####By definition.\n}\n" +
+		"\n[markdown]Pick {\n\t~%33.33333%a\n\t~%33.33333%b\n\t~%33.33333%c\n\t~%-100%d\n}\n" +
+		"\nSay $\\\\frac\\{1\\}\\{2\\}$ {\n\t=half\n}\n" +
+		"\nPi {#\n\t=3.14:0.01\n}\n" +
+		"\nMatch {\n\t=a -> 1\n\t=b -> 2\n}\n"
+
+	if text != want {
+		t.Errorf("WriteGIFT() = %q, want %q", text, want)
+	}
+
+	// Whatever is written reads back the same
+	parsed, errs := ParseGIFT(text)
+	if len(errs) != 0 {
+		t.Fatalf("ParseGIFT() errors = %v", errs)
+	}
+
+	for i := range questions {
+		parsed[i].Line = 0
+
+		if !reflect.DeepEqual(parsed[i], questions[i]) {
+			t.Errorf("question %d read back as %+v, want %+v", i, parsed[i], questions[i])
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package moodle
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+type QuestionType string
+
+const (
+	MultipleChoice   QuestionType = "multiple_choice"   // one correct choice
+	MultipleResponse QuestionType = "multiple_response" // several correct choices
+	ShortAnswer      QuestionType = "short_answer"
+	Numerical        QuestionType = "numerical"
+	Matching         QuestionType = "matching"
+)
+
+// Question is a question read from or written to a GIFT or Aiken file
+type Question struct {
+	Line     int // line the question starts on, 0 when it was not read from a file
+	Type     QuestionType
+	Text     string
+	Markdown bool
+
+	// Feedback is the general feedback shown once the question is answered
+	Feedback string
+
+	Choices []*Choice
+	Answers []string // accepted answers of a short answer question
+	Pairs   []*Pair
+
+	// expected value of a numerical question, Tolerance is absolute
+	Value     float64
+	Tolerance float64
+}
+
+type Choice struct {
+	Text     string
+	Correct  bool
+	Feedback string
+}
+
+type Pair struct {
+	Left  string
+	Right string
+}
+
+// LineError reports a construct of the file that could not be read
+type LineError struct {
+	Line    int
+	Message string
+}
+
+func (e *LineError) Error() string {
+	return fmt.Sprintf("line %d: %s", e.Line, e.Message)
+}
+
+// lineErrors collects errors so each line is reported once, messages of the same line joined
+type lineErrors map[int][]string
+
+func (e lineErrors) add(line int, format string, args ...any) {
+	e[line] = append(e[line], fmt.Sprintf(format, args...))
+}
+
+func (e lineErrors) list() []*LineError {
+	lines := make([]int, 0, len(e))
+	for line := range e {
+		lines = append(lines, line)
+	}
+
+	sort.Ints(lines)
+
+	errs := make([]*LineError, len(lines))
+	for i, line := range lines {
+		errs[i] = &LineError{Line: line, Message: strings.Join(e[line], "; ")}
+	}
+
+	return errs
+}
@@ -0,0 +1,39 @@
+package qti
+
+import "errors"
+
+const (
+	itemNamespace     = "http://www.imsglobal.org/xsd/imsqti_v2p1"
+	manifestNamespace = "http://www.imsglobal.org/xsd/imscp_v1p1"
+	itemResourceType  = "imsqti_item_xmlv2p1"
+	matchCorrect      = "http://www.imsglobal.org/question/qti_v2p1/rptemplates/match_correct"
+	manifestName      = "imsmanifest.xml"
+
+	// maxPartSize bounds how much of a single package file is decompressed
+	maxPartSize = 8 << 20
+)
+
+var ErrInvalidPackage = errors.New("file is not a valid qti content package")
+
+// Item is an assessment item holding a single choice interaction
+type Item struct {
+	Identifier string
+	Href       string // path of the item file in the package
+	Title      string
+	Prompt     string
+	Multiple   bool    // several choices may be selected
+	MaxScore   float64 // 0 when the item does not declare one
+	Choices    []*Choice
+}
+
+type Choice struct {
+	Identifier string
+	Text       string
+	Correct    bool
+}
+
+// SkippedItem is an item of a package that could not be read as a choice item, and why
+type SkippedItem struct {
+	Href   string
+	Reason string
+}
@@ -0,0 +1,133 @@
+package qti
+
+import (
+	"archive/zip"
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func TestWritePackage(t *testing.T) {
+	items := []*Item{
+		{
+			Identifier: "item-1", Title: "Question 1", Prompt: "Is 1 < 2?", MaxScore: 2,
+			Choices: []*Choice{{Identifier: "choice-1", Text: "Yes", Correct: true}, {Identifier: "choice-2", Text: "No"}},
+		},
+		{
+			Identifier: "item-2", Title: "Question 2", Prompt: "Pick the primes", Multiple: true, MaxScore: 1.5,
+			Choices: []*Choice{{Identifier: "choice-1", Text: "2", Correct: true}, {Identifier: "choice-2", Text: "3", Correct: true}, {Identifier: "choice-3", Text: "4"}},
+		},
+	}
+
+	data, err := WritePackage("module-1", items)
+	if err != nil {
+		t.Fatalf("WritePackage() error = %v", err)
+	}
+
+	// Whatever is written reads back the same
+	parsed, skipped, err := ReadPackage(data)
+	if err != nil {
+		t.Fatalf("ReadPackage() error = %v", err)
+	}
+
+	if len(skipped) != 0 {
+		t.Errorf("ReadPackage() skipped = %v", skipped)
+	}
+
+	for _, item := range items {
+		item.Href = "items/" + item.Identifier + ".xml"
+	}
+
+	if !reflect.DeepEqual(parsed, items) {
+		t.Errorf("ReadPackage() = %+v, want %+v", parsed, items)
+	}
+}
+
+func TestReadPackage(t *testing.T) {
+	const header = `<?xml version="1.0" encoding="UTF-8"?>`
+
+	files := map[string]string{
+		"imsmanifest.xml": header + `
+<manifest xmlns="http://www.imsglobal.org/xsd/imscp_v1p1" identifier="m">
+  <resources>
+    <resource identifier="a" type="imsqti_item_xmlv2p1" href="a.xml"/>
+    <resource identifier="b" type="imsqti_item_xmlv2p1" href="b.xml"/>
+    <resource identifier="c" type="imsqti_item_xmlv2p1" href="c.xml"/>
+    <resource identifier="d" type="imsqti_item_xmlv2p1" href="missing.xml"/>
+    <resource identifier="e" type="webcontent" href="image.png"/>
+  </resources>
+</manifest>`,
+		"a.xml": header + `
+<assessmentItem xmlns="http://www.imsglobal.org/xsd/imsqti_v2p1" identifier="a" title="A">
+  <responseDeclaration identifier="RESPONSE" cardinality="single" baseType="identifier">
+    <correctResponse><value>B</value></correctResponse>
+  </responseDeclaration>
+  <itemBody>
+    <p>What is <b>2 + 2</b>?</p>
+    <div>
+      <choiceInteraction responseIdentifier="RESPONSE" maxChoices="1">
+        <prompt>Pick one</prompt>
+        <simpleChoice identifier="A">3</simpleChoice>
+        <simpleChoice identifier="B">4 &amp; only 4</simpleChoice>
+      </choiceInteraction>
+    </div>
+  </itemBody>
+</assessmentItem>`,
+		"b.xml": header + `
+<assessmentItem xmlns="http://www.imsglobal.org/xsd/imsqti_v2p1" identifier="b" title="B">
+  <responseDeclaration identifier="RESPONSE" cardinality="single" baseType="string"/>
+  <itemBody><p>Capital of France <textEntryInteraction responseIdentifier="RESPONSE"/></p></itemBody>
+</assessmentItem>`,
+		"c.xml": header + `
+<assessmentItem xmlns="http://www.imsglobal.org/xsd/imsqti_v2p1" identifier="c" title="C">
+  <responseDeclaration identifier="RESPONSE" cardinality="single" baseType="identifier"/>
+  <itemBody>
+    <choiceInteraction responseIdentifier="RESPONSE" maxChoices="1">
+      <simpleChoice identifier="A">a</simpleChoice>
+    </choiceInteraction>
+  </itemBody>
+</assessmentItem>`,
+	}
+
+	var buf bytes.Buffer
+
+	archive := zip.NewWriter(&buf)
+	for name, content := range files {
+		file, _ := archive.Create(name)
+		file.Write([]byte(content))
+	}
+	archive.Close()
+
+	items, skipped, err := ReadPackage(buf.Bytes())
+	if err != nil {
+		t.Fatalf("ReadPackage() error = %v", err)
+	}
+
+	want := []*Item{
+		{
+			Identifier: "a", Href: "a.xml", Title: "A", Prompt: "What is 2 + 2?\nPick one",
+			Choices: []*Choice{{Identifier: "A", Text: "3"}, {Identifier: "B", Text: "4 & only 4", Correct: true}},
+		},
+	}
+
+	if !reflect.DeepEqual(items, want) {
+		t.Errorf("ReadPackage() = %+v, want %+v", items, want)
+	}
+
+	wantSkipped := []*SkippedItem{
+		{Href: "b.xml", Reason: "textEntryInteraction is not supported, only choiceInteraction is"},
+		{Href: "c.xml", Reason: "choiceInteraction has no correctResponse"},
+		{Href: "missing.xml", Reason: "item file is missing or is not valid xml"},
+	}
+
+	if !reflect.DeepEqual(skipped, wantSkipped) {
+		t.Errorf("ReadPackage() skipped = %+v, want %+v", skipped, wantSkipped)
+	}
+}
+
+func TestReadPackage_Invalid(t *testing.T) {
+	_, _, err := ReadPackage([]byte("not a zip"))
+	if err != ErrInvalidPackage {
+		t.Errorf("ReadPackage() error = %v, want %v", err, ErrInvalidPackage)
+	}
+}
@@ -0,0 +1,247 @@
+package qti
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"html"
+	"io"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var tagPattern = regexp.MustCompile(`<[^>]*>`)
+
+type manifest struct {
+	Resources []struct {
+		Type string `xml:"type,attr"`
+		Href string `xml:"href,attr"`
+	} `xml:"resources>resource"`
+}
+
+// node keeps any element along with its raw content, as items mix QTI markup with XHTML
+type node struct {
+	XMLName xml.Name
+	Attrs   []xml.Attr `xml:",any,attr"`
+	Inner   string     `xml:",innerxml"`
+	Nodes   []*node    `xml:",any"`
+}
+
+func (n *node) attr(name string) string {
+	for _, attr := range n.Attrs {
+		if attr.Name.Local == name {
+			return attr.Value
+		}
+	}
+
+	return ""
+}
+
+func (n *node) children(name string) []*node {
+	var nodes []*node
+
+	for _, child := range n.Nodes {
+		if child.XMLName.Local == name {
+			nodes = append(nodes, child)
+		}
+	}
+
+	return nodes
+}
+
+// text is the content of the element with its markup stripped and its whitespace collapsed
+func (n *node) text() string {
+	return strings.Join(strings.Fields(html.UnescapeString(tagPattern.ReplaceAllString(n.Inner, ""))), " ")
+}
+
+func (n *node) isInteraction() bool {
+	return strings.HasSuffix(n.XMLName.Local, "Interaction")
+}
+
+func (n *node) hasInteraction() bool {
+	for _, child := range n.Nodes {
+		if child.isInteraction() || child.hasInteraction() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ReadPackage reads the assessment items listed in the manifest of a QTI 2.x content package.
+// Items that are not a single choiceInteraction with a correct response are skipped and reported.
+func ReadPackage(data []byte) ([]*Item, []*SkippedItem, error) {
+	archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, nil, ErrInvalidPackage
+	}
+
+	var pkg manifest
+
+	err = readPart(archive, manifestName, &pkg)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		items   []*Item
+		skipped []*SkippedItem
+	)
+
+	for _, resource := range pkg.Resources {
+		if !strings.HasPrefix(resource.Type, "imsqti_item_xmlv2p") {
+			continue
+		}
+
+		var root node
+
+		err := readPart(archive, path.Clean(resource.Href), &root)
+		if err != nil {
+			skipped = append(skipped, &SkippedItem{Href: resource.Href, Reason: "item file is missing or is not valid xml"})
+			continue
+		}
+
+		item, reason := readItem(&root)
+		if item == nil {
+			skipped = append(skipped, &SkippedItem{Href: resource.Href, Reason: reason})
+			continue
+		}
+
+		item.Href = resource.Href
+		items = append(items, item)
+	}
+
+	return items, skipped, nil
+}
+
+func readItem(root *node) (*Item, string) {
+	if root.XMLName.Local != "assessmentItem" {
+		return nil, "file is not an assessmentItem"
+	}
+
+	bodies := root.children("itemBody")
+	if len(bodies) != 1 {
+		return nil, "item must have one itemBody"
+	}
+
+	var (
+		text         []string
+		interactions []*node
+	)
+
+	collectBody(bodies[0], &text, &interactions)
+
+	if len(interactions) == 0 {
+		return nil, "items without an interaction are not supported"
+	}
+
+	if len(interactions) > 1 {
+		return nil, "items with several interactions are not supported"
+	}
+
+	interaction := interactions[0]
+	if interaction.XMLName.Local != "choiceInteraction" {
+		return nil, fmt.Sprintf("%s is not supported, only choiceInteraction is", interaction.XMLName.Local)
+	}
+
+	var declaration *node
+	for _, candidate := range root.children("responseDeclaration") {
+		if candidate.attr("identifier") == interaction.attr("responseIdentifier") {
+			declaration = candidate
+		}
+	}
+
+	if declaration == nil {
+		return nil, "choiceInteraction has no responseDeclaration"
+	}
+
+	correct := make(map[string]bool)
+	for _, response := range declaration.children("correctResponse") {
+		for _, value := range response.children("value") {
+			correct[value.text()] = true
+		}
+	}
+
+	if len(correct) == 0 {
+		return nil, "choiceInteraction has no correctResponse"
+	}
+
+	for _, prompt := range interaction.children("prompt") {
+		text = append(text, prompt.text())
+	}
+
+	item := &Item{
+		Identifier: root.attr("identifier"),
+		Title:      root.attr("title"),
+		Prompt:     strings.TrimSpace(strings.Join(text, "\n")),
+		Multiple:   declaration.attr("cardinality") == "multiple",
+		MaxScore:   maxScore(root),
+	}
+
+	for _, choice := range interaction.children("simpleChoice") {
+		identifier := choice.attr("identifier")
+
+		item.Choices = append(item.Choices, &Choice{
+			Identifier: identifier,
+			Text:       choice.text(),
+			Correct:    correct[identifier],
+		})
+	}
+
+	return item, ""
+}
+
+// collectBody gathers the text of an item body and the interactions found in it, at any depth
+func collectBody(parent *node, text *[]string, interactions *[]*node) {
+	for _, child := range parent.Nodes {
+		switch {
+		case child.isInteraction():
+			*interactions = append(*interactions, child)
+		case child.hasInteraction():
+			collectBody(child, text, interactions)
+		default:
+			if content := child.text(); content != "" {
+				*text = append(*text, content)
+			}
+		}
+	}
+}
+
+// maxScore reads the default value of the MAXSCORE outcome, if the item declares one
+func maxScore(root *node) float64 {
+	for _, outcome := range root.children("outcomeDeclaration") {
+		if outcome.attr("identifier") != "MAXSCORE" {
+			continue
+		}
+
+		for _, defaultValue := range outcome.children("defaultValue") {
+			for _, value := range defaultValue.children("value") {
+				score, err := strconv.ParseFloat(value.text(), 64)
+				if err == nil {
+					return score
+				}
+			}
+		}
+	}
+
+	return 0
+}
+
+func readPart(archive *zip.Reader, name string, v any) error {
+	file, err := archive.Open(name)
+	if err != nil {
+		return ErrInvalidPackage
+	}
+
+	defer file.Close()
+
+	err = xml.NewDecoder(io.LimitReader(file, maxPartSize)).Decode(v)
+	if err != nil {
+		return ErrInvalidPackage
+	}
+
+	return nil
+}
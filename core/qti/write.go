@@ -0,0 +1,173 @@
+package qti
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"strconv"
+)
+
+type xmlManifest struct {
+	XMLName    xml.Name      `xml:"manifest"`
+	Namespace  string        `xml:"xmlns,attr"`
+	Identifier string        `xml:"identifier,attr"`
+	Schema     string        `xml:"metadata>schema"`
+	Version    string        `xml:"metadata>schemaversion"`
+	Resources  []xmlResource `xml:"resources>resource"`
+}
+
+type xmlResource struct {
+	Identifier string `xml:"identifier,attr"`
+	Type       string `xml:"type,attr"`
+	Href       string `xml:"href,attr"`
+	File       struct {
+		Href string `xml:"href,attr"`
+	} `xml:"file"`
+}
+
+type xmlItem struct {
+	XMLName             xml.Name                `xml:"assessmentItem"`
+	Namespace           string                  `xml:"xmlns,attr"`
+	Identifier          string                  `xml:"identifier,attr"`
+	Title               string                  `xml:"title,attr"`
+	Adaptive            bool                    `xml:"adaptive,attr"`
+	TimeDependent       bool                    `xml:"timeDependent,attr"`
+	ResponseDeclaration xmlResponseDeclaration  `xml:"responseDeclaration"`
+	Outcomes            []xmlOutcomeDeclaration `xml:"outcomeDeclaration"`
+	Interaction         xmlChoiceInteraction    `xml:"itemBody>choiceInteraction"`
+	ResponseProcessing  struct {
+		Template string `xml:"template,attr"`
+	} `xml:"responseProcessing"`
+}
+
+type xmlResponseDeclaration struct {
+	Identifier  string   `xml:"identifier,attr"`
+	Cardinality string   `xml:"cardinality,attr"`
+	BaseType    string   `xml:"baseType,attr"`
+	Correct     []string `xml:"correctResponse>value"`
+}
+
+type xmlOutcomeDeclaration struct {
+	Identifier  string `xml:"identifier,attr"`
+	Cardinality string `xml:"cardinality,attr"`
+	BaseType    string `xml:"baseType,attr"`
+	Default     string `xml:"defaultValue>value"`
+}
+
+type xmlChoiceInteraction struct {
+	ResponseIdentifier string            `xml:"responseIdentifier,attr"`
+	Shuffle            bool              `xml:"shuffle,attr"`
+	MaxChoices         int               `xml:"maxChoices,attr"`
+	Prompt             string            `xml:"prompt"`
+	Choices            []xmlSimpleChoice `xml:"simpleChoice"`
+}
+
+type xmlSimpleChoice struct {
+	Identifier string `xml:"identifier,attr"`
+	Text       string `xml:",chardata"`
+}
+
+// WritePackage renders the items as a QTI 2.1 content package: one assessmentItem file
+// per item under items/ and the imsmanifest.xml listing them. Items are scored with the
+// match_correct template, with their MaxScore declared as the MAXSCORE outcome.
+func WritePackage(identifier string, items []*Item) ([]byte, error) {
+	var buf bytes.Buffer
+
+	archive := zip.NewWriter(&buf)
+
+	pkg := xmlManifest{
+		Namespace:  manifestNamespace,
+		Identifier: identifier,
+		Schema:     "QTIv2.1 Package",
+		Version:    "1.0.0",
+	}
+
+	for _, item := range items {
+		href := "items/" + item.Identifier + ".xml"
+
+		resource := xmlResource{
+			Identifier: item.Identifier,
+			Type:       itemResourceType,
+			Href:       href,
+		}
+		resource.File.Href = href
+
+		pkg.Resources = append(pkg.Resources, resource)
+
+		err := writePart(archive, href, toXMLItem(item))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err := writePart(archive, manifestName, pkg)
+	if err != nil {
+		return nil, err
+	}
+
+	err = archive.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func toXMLItem(item *Item) xmlItem {
+	result := xmlItem{
+		Namespace:  itemNamespace,
+		Identifier: item.Identifier,
+		Title:      item.Title,
+		ResponseDeclaration: xmlResponseDeclaration{
+			Identifier:  "RESPONSE",
+			Cardinality: "single",
+			BaseType:    "identifier",
+		},
+		Outcomes: []xmlOutcomeDeclaration{
+			{Identifier: "SCORE", Cardinality: "single", BaseType: "float", Default: "0"},
+			{Identifier: "MAXSCORE", Cardinality: "single", BaseType: "float", Default: strconv.FormatFloat(item.MaxScore, 'f', -1, 64)},
+		},
+		Interaction: xmlChoiceInteraction{
+			ResponseIdentifier: "RESPONSE",
+			MaxChoices:         1,
+			Prompt:             item.Prompt,
+		},
+	}
+
+	result.ResponseProcessing.Template = matchCorrect
+
+	if item.Multiple {
+		result.ResponseDeclaration.Cardinality = "multiple"
+		result.Interaction.MaxChoices = 0
+	}
+
+	for _, choice := range item.Choices {
+		result.Interaction.Choices = append(result.Interaction.Choices, xmlSimpleChoice{
+			Identifier: choice.Identifier,
+			Text:       choice.Text,
+		})
+
+		if choice.Correct {
+			result.ResponseDeclaration.Correct = append(result.ResponseDeclaration.Correct, choice.Identifier)
+		}
+	}
+
+	return result
+}
+
+func writePart(archive *zip.Writer, name string, v any) error {
+	file, err := archive.Create(name)
+	if err != nil {
+		return err
+	}
+
+	_, err = file.Write([]byte(xml.Header))
+	if err != nil {
+		return err
+	}
+
+	encoder := xml.NewEncoder(file)
+	encoder.Indent("", "  ")
+
+	return encoder.Encode(v)
+}
@@ -0,0 +1,55 @@
+package spreadsheet
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"io"
+	"path/filepath"
+	"strings"
+)
+
+var (
+	ErrUnsupportedFormat = errors.New("spreadsheet must be a csv or xlsx file")
+	ErrInvalidWorkbook   = errors.New("spreadsheet is not a valid xlsx workbook")
+)
+
+// Read returns the rows of a CSV file or of the first sheet of an XLSX workbook, picked by the file extension.
+// Row i of the result is row i+1 of the spreadsheet, so blank rows are kept as empty slices.
+func Read(filename string, data []byte) ([][]string, error) {
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case ".csv":
+		return ReadCSV(data)
+	case ".xlsx":
+		return ReadXLSX(data)
+	default:
+		return nil, ErrUnsupportedFormat
+	}
+}
+
+// ReadCSV parses comma separated rows, ignoring the byte order mark spreadsheet programs prepend.
+// A record is placed at the line it starts on, even when a quoted cell spans several lines.
+func ReadCSV(data []byte) ([][]string, error) {
+	reader := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))))
+	reader.FieldsPerRecord = -1
+
+	var rows [][]string
+
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return rows, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		line, _ := reader.FieldPos(0)
+
+		for len(rows) < line-1 {
+			rows = append(rows, nil)
+		}
+
+		rows = append(rows, record)
+	}
+}
@@ -0,0 +1,121 @@
+package spreadsheet
+
+import (
+	"archive/zip"
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func TestReadCSV(t *testing.T) {
+	data := []byte("\xef\xbb\xbfcontent,choice_1,choice_2,correct\n" +
+		"\"What is 2 + 2?\",3,4,B\n" +
+		"\n" +
+		"\"Pick, carefully\",\"multi\nline\",x,1\n")
+
+	rows, err := Read("questions.CSV", data)
+	if err != nil {
+		t.Fatalf("Read() error = %v", err)
+	}
+
+	want := [][]string{
+		{"content", "choice_1", "choice_2", "correct"},
+		{"What is 2 + 2?", "3", "4", "B"},
+		nil,
+		{"Pick, carefully", "multi\nline", "x", "1"},
+	}
+
+	if !reflect.DeepEqual(rows, want) {
+		t.Errorf("Read() = %q, want %q", rows, want)
+	}
+}
+
+func TestReadXLSX(t *testing.T) {
+	data := buildWorkbook(t, map[string]string{
+		"xl/workbook.xml": `<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">
+			<sheets><sheet name="Questions" sheetId="1" r:id="rId3"/></sheets></workbook>`,
+		"xl/_rels/workbook.xml.rels": `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+			<Relationship Id="rId1" Target="styles.xml"/>
+			<Relationship Id="rId3" Target="worksheets/sheet1.xml"/></Relationships>`,
+		"xl/sharedStrings.xml": `<sst xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">
+			<si><t>content</t></si><si><t>points</t></si><si><r><t>What is </t></r><r><t>2 + 2?</t></r></si></sst>`,
+		"xl/worksheets/sheet1.xml": `<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>
+			<row r="1"><c r="A1" t="s"><v>0</v></c><c r="B1" t="s"><v>1</v></c></row>
+			<row r="3"><c r="A3" t="s"><v>2</v></c><c r="C3"><v>2.5</v></c><c r="D3" t="inlineStr"><is><t>inline</t></is></c><c r="E3" t="b"><v>1</v></c></row>
+			</sheetData></worksheet>`,
+	})
+
+	rows, err := Read("questions.xlsx", data)
+	if err != nil {
+		t.Fatalf("Read() error = %v", err)
+	}
+
+	want := [][]string{
+		{"content", "points"},
+		nil,
+		{"What is 2 + 2?", "", "2.5", "inline", "true"},
+	}
+
+	if !reflect.DeepEqual(rows, want) {
+		t.Errorf("Read() = %q, want %q", rows, want)
+	}
+}
+
+func TestReadInvalid(t *testing.T) {
+	if _, err := Read("questions.xls", []byte("anything")); err != ErrUnsupportedFormat {
+		t.Errorf("Read(xls) error = %v, want %v", err, ErrUnsupportedFormat)
+	}
+
+	if _, err := Read("questions.xlsx", []byte("not a zip")); err != ErrInvalidWorkbook {
+		t.Errorf("Read(not a zip) error = %v, want %v", err, ErrInvalidWorkbook)
+	}
+
+	data := buildWorkbook(t, map[string]string{
+		"xl/workbook.xml": `<workbook><sheets></sheets></workbook>`,
+	})
+
+	if _, err := Read("questions.xlsx", data); err != ErrInvalidWorkbook {
+		t.Errorf("Read(no sheets) error = %v, want %v", err, ErrInvalidWorkbook)
+	}
+}
+
+func TestColumnIndex(t *testing.T) {
+	tests := map[string]int{
+		"A1":   0,
+		"Z9":   25,
+		"AA10": 26,
+		"AB12": 27,
+		"XFD1": 16383,
+	}
+
+	for reference, want := range tests {
+		if got := columnIndex(reference); got != want {
+			t.Errorf("columnIndex(%q) = %d, want %d", reference, got, want)
+		}
+	}
+}
+
+func buildWorkbook(t *testing.T, parts map[string]string) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+
+	archive := zip.NewWriter(&buf)
+
+	for name, content := range parts {
+		part, err := archive.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := part.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := archive.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	return buf.Bytes()
+}
@@ -0,0 +1,230 @@
+package spreadsheet
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"io"
+	"io/fs"
+	"path"
+	"strconv"
+	"strings"
+)
+
+const (
+	// maxPartSize bounds how much of a single workbook part is decompressed, guarding against zip bombs
+	maxPartSize = 32 << 20
+
+	// the largest sheet Excel itself can hold
+	maxRows    = 1048576
+	maxColumns = 16384
+)
+
+type xlsxWorkbook struct {
+	Sheets []struct {
+		RelationshipID string `xml:"http://schemas.openxmlformats.org/officeDocument/2006/relationships id,attr"`
+	} `xml:"sheets>sheet"`
+}
+
+type xlsxRelationships struct {
+	Relationships []struct {
+		ID     string `xml:"Id,attr"`
+		Target string `xml:"Target,attr"`
+	} `xml:"Relationship"`
+}
+
+type xlsxSharedStrings struct {
+	Items []xlsxText `xml:"si"`
+}
+
+// xlsxText is either a plain <t> or rich text split in <r><t> runs
+type xlsxText struct {
+	Text string `xml:"t"`
+	Runs []struct {
+		Text string `xml:"t"`
+	} `xml:"r"`
+}
+
+func (t xlsxText) String() string {
+	var result strings.Builder
+
+	result.WriteString(t.Text)
+
+	for _, run := range t.Runs {
+		result.WriteString(run.Text)
+	}
+
+	return result.String()
+}
+
+type xlsxWorksheet struct {
+	Rows []struct {
+		Number int `xml:"r,attr"`
+		Cells  []struct {
+			Reference string    `xml:"r,attr"`
+			Type      string    `xml:"t,attr"`
+			Value     string    `xml:"v"`
+			Inline    *xlsxText `xml:"is"`
+		} `xml:"c"`
+	} `xml:"sheetData>row"`
+}
+
+// ReadXLSX returns the cell text of the first sheet of the workbook.
+// Numbers and booleans come back as stored, without the cell's display format.
+func ReadXLSX(data []byte) ([][]string, error) {
+	archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, ErrInvalidWorkbook
+	}
+
+	sheetPath, err := firstSheetPath(archive)
+	if err != nil {
+		return nil, err
+	}
+
+	// Workbooks without any text cells have no shared strings part
+	var sharedStrings xlsxSharedStrings
+
+	if _, err := fs.Stat(archive, "xl/sharedStrings.xml"); err == nil {
+		err = readPart(archive, "xl/sharedStrings.xml", &sharedStrings)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var worksheet xlsxWorksheet
+
+	err = readPart(archive, sheetPath, &worksheet)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows [][]string
+
+	for _, row := range worksheet.Rows {
+		// Rows without a number follow the previous one
+		number := row.Number
+		if number <= 0 {
+			number = len(rows) + 1
+		}
+
+		if number > maxRows {
+			return nil, ErrInvalidWorkbook
+		}
+
+		for len(rows) < number {
+			rows = append(rows, nil)
+		}
+
+		var cells []string
+
+		for _, cell := range row.Cells {
+			column := len(cells)
+			if cell.Reference != "" {
+				column = columnIndex(cell.Reference)
+			}
+
+			if column < 0 || column >= maxColumns {
+				return nil, ErrInvalidWorkbook
+			}
+
+			for len(cells) <= column {
+				cells = append(cells, "")
+			}
+
+			switch cell.Type {
+			case "s":
+				index, err := strconv.Atoi(cell.Value)
+				if err != nil || index < 0 || index >= len(sharedStrings.Items) {
+					return nil, ErrInvalidWorkbook
+				}
+
+				cells[column] = sharedStrings.Items[index].String()
+			case "inlineStr":
+				if cell.Inline != nil {
+					cells[column] = cell.Inline.String()
+				}
+			case "b":
+				cells[column] = strconv.FormatBool(cell.Value == "1")
+			default:
+				cells[column] = cell.Value
+			}
+		}
+
+		rows[number-1] = cells
+	}
+
+	return rows, nil
+}
+
+// firstSheetPath follows the workbook relationships to the part holding the first sheet
+func firstSheetPath(archive *zip.Reader) (string, error) {
+	var workbook xlsxWorkbook
+
+	err := readPart(archive, "xl/workbook.xml", &workbook)
+	if err != nil {
+		return "", err
+	}
+
+	if len(workbook.Sheets) == 0 {
+		return "", ErrInvalidWorkbook
+	}
+
+	var relationships xlsxRelationships
+
+	err = readPart(archive, "xl/_rels/workbook.xml.rels", &relationships)
+	if err != nil {
+		return "", err
+	}
+
+	for _, relationship := range relationships.Relationships {
+		if relationship.ID != workbook.Sheets[0].RelationshipID {
+			continue
+		}
+
+		// Targets are relative to xl/ unless they start at the package root
+		if strings.HasPrefix(relationship.Target, "/") {
+			return strings.TrimPrefix(relationship.Target, "/"), nil
+		}
+
+		return path.Join("xl", relationship.Target), nil
+	}
+
+	return "", ErrInvalidWorkbook
+}
+
+func readPart(archive *zip.Reader, name string, v any) error {
+	file, err := archive.Open(name)
+	if err != nil {
+		return ErrInvalidWorkbook
+	}
+
+	defer file.Close()
+
+	err = xml.NewDecoder(io.LimitReader(file, maxPartSize)).Decode(v)
+	if err != nil {
+		return ErrInvalidWorkbook
+	}
+
+	return nil
+}
+
+// columnIndex turns the letters of a cell reference such as "AB12" into a zero-based column
+func columnIndex(reference string) int {
+	index := 0
+
+	for _, r := range reference {
+		if r < 'A' || r > 'Z' {
+			break
+		}
+
+		index = index*26 + int(r-'A'+1)
+
+		// no need to keep counting once the reference is past the last column
+		if index > maxColumns {
+			break
+		}
+	}
+
+	return index - 1
+}
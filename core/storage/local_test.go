@@ -0,0 +1,65 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLocalSignedURL(t *testing.T) {
+	l := NewLocal(t.TempDir(), "http://localhost:8000/v1/attachments/", "secret")
+	ctx := context.Background()
+
+	err := l.Put(ctx, "attachments/map.png", strings.NewReader("png"), 3, "image/png")
+	if err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+
+	signed, err := l.SignedURL(ctx, "attachments/map.png", time.Minute)
+	if err != nil {
+		t.Fatalf("SignedURL() error = %v", err)
+	}
+
+	u, _ := url.Parse(signed)
+	if u.Path != "/v1/attachments/attachments/map.png" {
+		t.Errorf("SignedURL() path = %s", u.Path)
+	}
+
+	expires, signature := u.Query().Get("expires"), u.Query().Get("signature")
+
+	file, err := l.Open("attachments/map.png", expires, signature)
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+
+	content, _ := io.ReadAll(file)
+	file.Close()
+
+	if string(content) != "png" {
+		t.Errorf("Open() content = %q, want %q", content, "png")
+	}
+
+	tests := []struct {
+		name      string
+		key       string
+		expires   string
+		signature string
+		want      error
+	}{
+		{"tampered signature", "attachments/map.png", expires, strings.Repeat("0", len(signature)), ErrInvalidSignature},
+		{"other key", "attachments/other.png", expires, signature, ErrInvalidSignature},
+		{"expired", "attachments/map.png", "1", signature, ErrInvalidSignature},
+		{"escaping key", "../map.png", expires, signature, ErrInvalidKey},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := l.Open(tt.key, tt.expires, tt.signature); err != tt.want {
+				t.Errorf("Open() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
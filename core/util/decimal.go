@@ -0,0 +1,46 @@
+package util
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrInvalidDecimal = errors.New("invalid decimal number")
+
+	decimalPattern = regexp.MustCompile(`^[+-]?(\d+\.?\d*|\.\d+)$`)
+)
+
+// ParseDecimal parses a decimal number written with either a comma or a dot as the decimal separator.
+// When both appear, the last one is the decimal separator and the other groups thousands ("1.234,5", "1,234.5").
+// A single separator is always read as the decimal one, so "1,234" is 1.234.
+func ParseDecimal(text string) (float64, error) {
+	text = strings.TrimSpace(text)
+
+	lastComma := strings.LastIndex(text, ",")
+	lastDot := strings.LastIndex(text, ".")
+
+	switch {
+	case lastComma >= 0 && lastDot >= 0:
+		if lastComma > lastDot {
+			text = strings.ReplaceAll(text, ".", "")
+			text = strings.Replace(text, ",", ".", 1)
+		} else {
+			text = strings.ReplaceAll(text, ",", "")
+		}
+	case strings.Count(text, ",") > 1:
+		text = strings.ReplaceAll(text, ",", "")
+	case strings.Count(text, ".") > 1:
+		text = strings.ReplaceAll(text, ".", "")
+	default:
+		text = strings.Replace(text, ",", ".", 1)
+	}
+
+	if !decimalPattern.MatchString(text) {
+		return 0, ErrInvalidDecimal
+	}
+
+	return strconv.ParseFloat(text, 64)
+}
@@ -0,0 +1,44 @@
+package util
+
+import (
+	"testing"
+)
+
+func TestParseDecimal(t *testing.T) {
+	tests := map[string]float64{
+		"3.5":       3.5,
+		"3,5":       3.5,
+		" -0,25 ":   -0.25,
+		"+7":        7,
+		",5":        0.5,
+		"1.234,5":   1234.5,
+		"1,234.5":   1234.5,
+		"1,234,567": 1234567,
+		"1.234.567": 1234567,
+		"1,234":     1.234,
+		"12.":       12,
+		"0":         0,
+	}
+
+	for input, want := range tests {
+		got, err := ParseDecimal(input)
+		if err != nil {
+			t.Errorf("ParseDecimal(%q) returned error: %v", input, err)
+			continue
+		}
+
+		if got != want {
+			t.Errorf("ParseDecimal(%q) = %v, want %v", input, got, want)
+		}
+	}
+}
+
+func TestParseDecimal_Invalid(t *testing.T) {
+	inputs := []string{"", "abc", "3,5cm", "1e5", "NaN", "Inf", "--1", "1,2.3,4", "."}
+
+	for _, input := range inputs {
+		if _, err := ParseDecimal(input); err == nil {
+			t.Errorf("ParseDecimal(%q) expected error, got nil", input)
+		}
+	}
+}
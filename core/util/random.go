@@ -4,6 +4,8 @@ import (
 	"crypto/rand"
 	"crypto/sha256"
 	"encoding/binary"
+	"math"
+	mathrand "math/rand/v2"
 	"time"
 )
 
@@ -46,3 +48,37 @@ func RandomAlphanumeric(length int) (string, error) {
 
 	return string(result), nil
 }
+
+// RandomSeed returns a positive seed for SeededShuffle
+func RandomSeed() (int64, error) {
+	seedBytes := make([]byte, 8)
+	if _, err := rand.Read(seedBytes); err != nil {
+		return 0, err
+	}
+
+	return int64(binary.LittleEndian.Uint64(seedBytes)%math.MaxInt64) + 1, nil
+}
+
+// SeedOf derives a positive seed for SeededShuffle from the given values, the same one every time
+func SeedOf(values ...string) int64 {
+	hash := sha256.New()
+	for _, value := range values {
+		// The length prefix keeps ("ab", "c") and ("a", "bc") apart
+		binary.Write(hash, binary.LittleEndian, uint64(len(value)))
+		hash.Write([]byte(value))
+	}
+
+	return int64(binary.LittleEndian.Uint64(hash.Sum(nil))%math.MaxInt64) + 1
+}
+
+// SeededShuffle shuffles n elements the same way every time it is given the same seed.
+// It draws from PCG directly rather than through rand.Shuffle, whose algorithm is not
+// promised to stay the same across Go releases, so a stored seed keeps its order.
+func SeededShuffle(seed int64, n int, swap func(i, j int)) {
+	source := mathrand.NewPCG(uint64(seed), 0)
+
+	for i := n - 1; i > 0; i-- {
+		j := int(source.Uint64() % uint64(i+1))
+		swap(i, j)
+	}
+}
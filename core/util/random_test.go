@@ -1,6 +1,7 @@
 package util
 
 import (
+	"slices"
 	"testing"
 )
 
@@ -96,3 +97,38 @@ func TestRandomAlphanumeric_Uniqueness(t *testing.T) {
 		t.Errorf("Too many collisions: %v out of %v (%.2f%%)", collisions, iterations, collisionRate*100)
 	}
 }
+
+func TestSeedOf(t *testing.T) {
+	seed := SeedOf("A1B2C3D4E5F6G7H8", "abc123def456")
+	if seed <= 0 {
+		t.Errorf("SeedOf() = %v, want a positive seed", seed)
+	}
+
+	if again := SeedOf("A1B2C3D4E5F6G7H8", "abc123def456"); again != seed {
+		t.Errorf("SeedOf() = %v, then %v for the same values", seed, again)
+	}
+
+	if SeedOf("ab", "c") == SeedOf("a", "bc") {
+		t.Error("SeedOf() gives the same seed for values split differently")
+	}
+}
+
+func TestSeededShuffle(t *testing.T) {
+	shuffle := func(seed int64) []int {
+		items := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+		SeededShuffle(seed, len(items), func(i, j int) {
+			items[i], items[j] = items[j], items[i]
+		})
+		return items
+	}
+
+	// The order is part of stored submissions and must never change for a seed
+	want := []int{9, 7, 2, 1, 6, 10, 3, 8, 5, 4}
+	if got := shuffle(42); !slices.Equal(got, want) {
+		t.Errorf("SeededShuffle(42) = %v, want %v", got, want)
+	}
+
+	if got := shuffle(43); slices.Equal(got, want) {
+		t.Errorf("SeededShuffle(43) = %v, same as seed 42", got)
+	}
+}
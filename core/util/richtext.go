@@ -0,0 +1,116 @@
+package util
+
+import (
+	"html"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+var (
+	// $$…$$, $…$, \(…\) and \[…\] delimit LaTeX math
+	mathPattern = regexp.MustCompile(`\$\$[\s\S]+?\$\$|\$[^$\n]+?\$|\\\([\s\S]+?\\\)|\\\[[\s\S]+?\\\]`)
+
+	// markdown autolinks, the only "<" markdown content keeps
+	autolinkPattern = regexp.MustCompile(`<(?i:https?://|mailto:)[^<>\s]*>`)
+	tagOpenPattern  = regexp.MustCompile(`<([A-Za-z/!?])`)
+
+	// commands that let a LaTeX renderer emit links, images or raw HTML
+	latexCommandPattern = regexp.MustCompile(`\\(?:href|url|includegraphics|html[A-Za-z]*)\b`)
+
+	// a destination may hold balanced parentheses, as in "javascript:alert(1)"
+	linkDestinationPattern = regexp.MustCompile(`(\]\(\s*)((?:[^()\s]|\([^()\s]*\))+)`)
+	referencePattern       = regexp.MustCompile(`(?m)^( {0,3}\[[^\]]+\]:[ \t]*)(\S+)`)
+	schemePattern          = regexp.MustCompile(`^[a-z][a-z0-9+.-]*:`)
+
+	linkPattern     = regexp.MustCompile(`!?\[([^\]]*)\]\([^)]*\)`)
+	markdownPattern = regexp.MustCompile("(?m)^ {0,3}(?:#{1,6}|>|[-*+]|\\d+[.)])[ \t]+|[*_~`]")
+)
+
+// SanitizeRichText keeps a browser from running anything in question content: "<" is escaped
+// so text like "a<b" reads as written but never opens a tag, LaTeX commands that emit links
+// or HTML are removed and, for markdown, link destinations that are not http, https, mailto
+// or relative are replaced by "#". Markdown autolinks are kept, and LaTeX math is kept intact
+// apart from a space after "<" so "$a<b$" can never open a tag. Sanitizing twice changes nothing.
+func SanitizeRichText(content string, markdown bool) string {
+	content = latexCommandPattern.ReplaceAllString(content, "")
+
+	var result strings.Builder
+
+	last := 0
+	for _, loc := range mathPattern.FindAllStringIndex(content, -1) {
+		result.WriteString(escapeHTML(content[last:loc[0]], markdown))
+		result.WriteString(tagOpenPattern.ReplaceAllString(content[loc[0]:loc[1]], "< $1"))
+		last = loc[1]
+	}
+
+	result.WriteString(escapeHTML(content[last:], markdown))
+
+	sanitized := result.String()
+
+	if markdown {
+		sanitized = linkDestinationPattern.ReplaceAllStringFunc(sanitized, func(match string) string {
+			parts := linkDestinationPattern.FindStringSubmatch(match)
+			return parts[1] + safeDestination(parts[2])
+		})
+
+		sanitized = referencePattern.ReplaceAllStringFunc(sanitized, func(match string) string {
+			parts := referencePattern.FindStringSubmatch(match)
+			return parts[1] + safeDestination(parts[2])
+		})
+	}
+
+	return strings.TrimSpace(sanitized)
+}
+
+// RenderedLength counts the characters a student reads once the content is rendered,
+// leaving out markdown markup and link destinations and counting an escaped character once
+func RenderedLength(content string, markdown bool) int {
+	if markdown {
+		content = linkPattern.ReplaceAllString(content, "$1")
+		content = markdownPattern.ReplaceAllString(content, "")
+	}
+
+	return utf8.RuneCountInString(CollapseSpaces(html.UnescapeString(content)))
+}
+
+// escapeHTML escapes every "<" of the text, apart from markdown autolinks such as <https://…>
+func escapeHTML(text string, markdown bool) string {
+	if !markdown {
+		return strings.ReplaceAll(text, "<", "&lt;")
+	}
+
+	var result strings.Builder
+
+	last := 0
+	for _, loc := range autolinkPattern.FindAllStringIndex(text, -1) {
+		result.WriteString(strings.ReplaceAll(text[last:loc[0]], "<", "&lt;"))
+		result.WriteString(text[loc[0]:loc[1]])
+		last = loc[1]
+	}
+
+	result.WriteString(strings.ReplaceAll(text[last:], "<", "&lt;"))
+
+	return result.String()
+}
+
+// safeDestination replaces a link destination with "#" unless it is relative or uses an allowed scheme
+func safeDestination(destination string) string {
+	// browsers ignore entities, escapes, whitespace and control characters when reading a scheme
+	normalized := strings.ReplaceAll(html.UnescapeString(destination), `\`, "")
+	normalized = strings.Map(func(r rune) rune {
+		if r <= ' ' || r == 0x7f {
+			return -1
+		}
+		return r
+	}, normalized)
+	normalized = strings.ToLower(strings.TrimPrefix(normalized, "<"))
+
+	scheme := schemePattern.FindString(normalized)
+	switch scheme {
+	case "", "http:", "https:", "mailto:":
+		return destination
+	default:
+		return "#"
+	}
+}
@@ -0,0 +1,69 @@
+package util
+
+import (
+	"testing"
+)
+
+func TestSanitizeRichText(t *testing.T) {
+	tests := []struct {
+		content  string
+		markdown bool
+		want     string
+	}{
+		{"What is the capital of France?", false, "What is the capital of France?"},
+		{"Hello <script>alert(1)</script>world", false, "Hello &lt;script>alert(1)&lt;/script>world"},
+		{`<img src=x onerror="alert(1)">Photo`, false, `&lt;img src=x onerror="alert(1)">Photo`},
+		{"Tail <img src=x onerror=alert(1)", false, "Tail &lt;img src=x onerror=alert(1)"},
+		{"Keep <!-- hidden --> text", false, "Keep &lt;!-- hidden --> text"},
+		{"Is 3 < 5?", false, "Is 3 &lt; 5?"},
+		{"Which is larger: a<b or b<a", false, "Which is larger: a&lt;b or b&lt;a"},
+		{"Is x<y and y>z?", false, "Is x&lt;y and y>z?"},
+		{"Is x<y and y>z?", true, "Is x&lt;y and y>z?"},
+		{"Is 3 &lt; 5?", false, "Is 3 &lt; 5?"},
+		{"Solve $a<b$ and $$x<y$$", false, "Solve $a< b$ and $$x< y$$"},
+		{`Inline \(a<img src=x onerror=alert(1)>\)`, false, `Inline \(a< img src=x onerror=alert(1)>\)`},
+		{`$\href{javascript:alert(1)}{click}$`, false, `${javascript:alert(1)}{click}$`},
+		{`\url{https://example.com} \htmlClass{x}{y}`, false, `{https://example.com} {x}{y}`},
+		{"[site](https://example.com)", true, "[site](https://example.com)"},
+		{"[docs](/help#top)", true, "[docs](/help#top)"},
+		{"[x](javascript:alert(1))", true, "[x](#)"},
+		{"![x](JaVaScRiPt:alert(1))", true, "![x](#)"},
+		{"[x](&#106;avascript:alert(1))", true, "[x](#)"},
+		{`[x](java\script:alert(1))`, true, "[x](#)"},
+		{"[ref]: data:text/html;base64,PHNjcmlwdD4=", true, "[ref]: #"},
+		{"<https://example.com>", true, "<https://example.com>"},
+		{"<javascript:alert(1)>", true, "&lt;javascript:alert(1)>"},
+		{"[x](<javascript:alert(1)>)", true, "[x](#)"},
+		{"[wiki](https://en.wikipedia.org/wiki/Set_(mathematics)) ok", true, "[wiki](https://en.wikipedia.org/wiki/Set_(mathematics)) ok"},
+		{"<https://example.com>", false, "&lt;https://example.com>"},
+		{"[x](javascript:alert(1))", false, "[x](javascript:alert(1))"},
+	}
+
+	for _, tt := range tests {
+		if got := SanitizeRichText(tt.content, tt.markdown); got != tt.want {
+			t.Errorf("SanitizeRichText(%q, %v) = %q, want %q", tt.content, tt.markdown, got, tt.want)
+		}
+	}
+}
+
+func TestRenderedLength(t *testing.T) {
+	tests := []struct {
+		content  string
+		markdown bool
+		want     int
+	}{
+		{"  plain   text ", false, 10},
+		{"**bold** and _italic_", true, 15},
+		{"[site](https://example.com/a/very/long/path)", true, 4},
+		{"# Heading\n- item", true, 12},
+		{"**bold**", false, 8},
+		{"héllo", false, 5},
+		{"a &lt; b", false, 5},
+	}
+
+	for _, tt := range tests {
+		if got := RenderedLength(tt.content, tt.markdown); got != tt.want {
+			t.Errorf("RenderedLength(%q, %v) = %d, want %d", tt.content, tt.markdown, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,27 @@
+package util
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// CollapseSpaces trims the text and replaces every run of whitespace with a single space
+func CollapseSpaces(text string) string {
+	return strings.Join(strings.Fields(text), " ")
+}
+
+// RemoveDiacritics strips combining marks so that "café" and "cafe" compare equal
+func RemoveDiacritics(text string) string {
+	t := transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+
+	result, _, err := transform.String(t, text)
+	if err != nil {
+		return text
+	}
+
+	return result
+}
@@ -0,0 +1,37 @@
+package util
+
+import (
+	"testing"
+)
+
+func TestCollapseSpaces(t *testing.T) {
+	tests := map[string]string{
+		"":                    "",
+		"   ":                 "",
+		"  photosynthesis ":   "photosynthesis",
+		"ibu  kota\tnegara\n": "ibu kota negara",
+		"already normal text": "already normal text",
+	}
+
+	for input, want := range tests {
+		if got := CollapseSpaces(input); got != want {
+			t.Errorf("CollapseSpaces(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
+
+func TestRemoveDiacritics(t *testing.T) {
+	tests := map[string]string{
+		"café":      "cafe",
+		"naïve":     "naive",
+		"Ångström":  "Angstrom",
+		"São Paulo": "Sao Paulo",
+		"plain":     "plain",
+	}
+
+	for input, want := range tests {
+		if got := RemoveDiacritics(input); got != want {
+			t.Errorf("RemoveDiacritics(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
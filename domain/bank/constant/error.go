@@ -0,0 +1,11 @@
+package constant
+
+import "errors"
+
+var (
+	ErrBankQuestionNotFound = errors.New("bank question not found")
+
+	// Context mapping errors - bank's perspective on related entities
+	ErrSubjectNotFound = errors.New("subject not found")
+	ErrGradeNotFound   = errors.New("grade not found")
+)
@@ -0,0 +1,26 @@
+package constant
+
+type QuestionType string
+
+const (
+	SingleChoice   QuestionType = "single_choice"
+	MultipleSelect QuestionType = "multiple_select"
+	Matching       QuestionType = "matching"
+	ShortAnswer    QuestionType = "short_answer"
+	Numeric        QuestionType = "numeric"
+	Ordering       QuestionType = "ordering"
+)
+
+type ContentFormat string
+
+const (
+	PlainText ContentFormat = "plain"
+	Markdown  ContentFormat = "markdown"
+)
+
+type ToleranceType string
+
+const (
+	AbsoluteTolerance   ToleranceType = "absolute"
+	PercentageTolerance ToleranceType = "percentage"
+)
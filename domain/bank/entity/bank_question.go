@@ -0,0 +1,184 @@
+package entity
+
+import (
+	"strings"
+
+	"github.com/arvinpaundra/private-api/core/trait"
+	"github.com/arvinpaundra/private-api/core/util"
+	"github.com/arvinpaundra/private-api/domain/bank/constant"
+)
+
+// BankQuestion is a teacher's reusable question that modules can copy or reference
+type BankQuestion struct {
+	trait.Createable
+	trait.Updateable
+	trait.Removeable
+
+	ID        string
+	UserID    string
+	SubjectID string
+	GradeID   string
+	Type      constant.QuestionType
+	Content   string
+	Points    float64
+
+	// ContentFormat tells how the question and its choices are rendered
+	ContentFormat constant.ContentFormat
+
+	// Rationale explains the answer to students once they have answered
+	Rationale string
+
+	// Matching rules for short answer questions
+	CaseSensitive       bool
+	DiacriticsSensitive bool
+
+	// Expected value for numeric questions
+	NumericValue  *float64
+	Tolerance     float64
+	ToleranceType constant.ToleranceType
+
+	Choices         []*Choice
+	Pairs           []*Pair
+	AcceptedAnswers []*AcceptedAnswer
+	Units           []string
+
+	Tags []string
+}
+
+func NewBankQuestion(
+	userID, subjectID, gradeID, content string,
+	contentFormat constant.ContentFormat,
+	questionType constant.QuestionType,
+) *BankQuestion {
+	question := &BankQuestion{
+		ID:        util.GenerateUUID(),
+		UserID:    userID,
+		SubjectID: subjectID,
+		GradeID:   gradeID,
+		Type:      questionType,
+	}
+
+	question.setContent(content, contentFormat)
+
+	question.MarkCreate()
+
+	return question
+}
+
+func (q *BankQuestion) UpdateDetail(
+	subjectID, gradeID, content string,
+	contentFormat constant.ContentFormat,
+	questionType constant.QuestionType,
+) {
+	q.SubjectID = subjectID
+	q.GradeID = gradeID
+	q.Type = questionType
+	q.setContent(content, contentFormat)
+	q.MarkUpdate()
+}
+
+// SanitizeContent cleans choice or pair content the same way as the question content
+func (q *BankQuestion) SanitizeContent(content string) string {
+	return util.SanitizeRichText(content, q.ContentFormat == constant.Markdown)
+}
+
+// setContent stores the content without anything a browser could run, plain text by default
+func (q *BankQuestion) setContent(content string, contentFormat constant.ContentFormat) {
+	if contentFormat == "" {
+		contentFormat = constant.PlainText
+	}
+
+	q.ContentFormat = contentFormat
+	q.Content = util.SanitizeRichText(content, contentFormat == constant.Markdown)
+}
+
+// ClearAnswers drops every answer so they can be replaced as a whole
+func (q *BankQuestion) ClearAnswers() {
+	q.Choices = nil
+	q.Pairs = nil
+	q.AcceptedAnswers = nil
+	q.Units = nil
+	q.NumericValue = nil
+	q.Tolerance = 0
+}
+
+func (q *BankQuestion) AddChoice(content string, isCorrectAnswer bool, correctPosition int, explanation string) {
+	q.Choices = append(q.Choices, &Choice{
+		Content:         content,
+		IsCorrectAnswer: isCorrectAnswer,
+		CorrectPosition: correctPosition,
+		Explanation:     explanation,
+	})
+}
+
+func (q *BankQuestion) AddPair(leftContent, rightContent string) {
+	q.Pairs = append(q.Pairs, &Pair{
+		LeftContent:  leftContent,
+		RightContent: rightContent,
+	})
+}
+
+func (q *BankQuestion) AddAcceptedAnswer(content string, isRegex bool) {
+	q.AcceptedAnswers = append(q.AcceptedAnswers, &AcceptedAnswer{
+		Content: content,
+		IsRegex: isRegex,
+	})
+}
+
+func (q *BankQuestion) AddUnit(name string) {
+	q.Units = append(q.Units, name)
+}
+
+func (q *BankQuestion) UpdateRationale(rationale string) {
+	q.Rationale = q.SanitizeContent(rationale)
+}
+
+func (q *BankQuestion) UpdatePoints(points float64) {
+	q.Points = points
+}
+
+func (q *BankQuestion) UpdateMatchRules(caseSensitive, diacriticsSensitive bool) {
+	q.CaseSensitive = caseSensitive
+	q.DiacriticsSensitive = diacriticsSensitive
+}
+
+func (q *BankQuestion) UpdateNumericAnswer(value *float64, tolerance float64, toleranceType constant.ToleranceType) {
+	q.NumericValue = value
+	q.Tolerance = tolerance
+	q.ToleranceType = toleranceType
+}
+
+// UpdateTags replaces the tags, lower-cased and without duplicates so searching by tag is predictable
+func (q *BankQuestion) UpdateTags(tags []string) {
+	q.Tags = nil
+
+	seen := make(map[string]bool)
+
+	for _, tag := range tags {
+		name := strings.ToLower(strings.TrimSpace(tag))
+		if name == "" || seen[name] {
+			continue
+		}
+
+		seen[name] = true
+		q.Tags = append(q.Tags, name)
+	}
+}
+
+// Choice is a bank question choice, kept in the order it was authored in
+type Choice struct {
+	Content         string
+	IsCorrectAnswer bool
+	CorrectPosition int
+	Explanation     string
+}
+
+type Pair struct {
+	LeftContent  string
+	RightContent string
+}
+
+type AcceptedAnswer struct {
+	Content string
+	IsRegex bool
+}
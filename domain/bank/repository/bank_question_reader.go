@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/arvinpaundra/private-api/domain/bank/entity"
+)
+
+type BankQuestionReader interface {
+	FindByID(ctx context.Context, questionID, userID string) (*entity.BankQuestion, error)
+	TotalBankQuestions(ctx context.Context, userID, subjectID, gradeID, tag, keyword string) (int, error)
+	FindAllBankQuestions(ctx context.Context, userID, subjectID, gradeID, tag, keyword string, limit, offset int) ([]*entity.BankQuestion, error)
+}
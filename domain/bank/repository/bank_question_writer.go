@@ -0,0 +1,11 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/arvinpaundra/private-api/domain/bank/entity"
+)
+
+type BankQuestionWriter interface {
+	Save(ctx context.Context, question *entity.BankQuestion) error
+}
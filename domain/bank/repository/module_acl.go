@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/arvinpaundra/private-api/domain/bank/entity"
+)
+
+type ModuleACL interface {
+	// ValidateQuestion checks the answers against the rules module questions follow
+	ValidateQuestion(ctx context.Context, question *entity.BankQuestion) error
+	// ValidateLinkedQuestions checks the question fits every unpublished module question that references it
+	ValidateLinkedQuestions(ctx context.Context, question *entity.BankQuestion, userID string) error
+	// SyncLinkedQuestions copies the question into every unpublished module question that references it
+	SyncLinkedQuestions(ctx context.Context, question *entity.BankQuestion, userID string) error
+}
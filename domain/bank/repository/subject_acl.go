@@ -0,0 +1,9 @@
+package repository
+
+import (
+	"context"
+)
+
+type SubjectACL interface {
+	IsSubjectExist(ctx context.Context, subjectID string, userID string) (bool, error)
+}
@@ -0,0 +1,41 @@
+package response
+
+import "github.com/arvinpaundra/private-api/domain/bank/constant"
+
+type BankQuestion struct {
+	ID                  string                 `json:"id"`
+	SubjectID           string                 `json:"subject_id"`
+	GradeID             string                 `json:"grade_id"`
+	Type                constant.QuestionType  `json:"type"`
+	Content             string                 `json:"content"`
+	ContentFormat       constant.ContentFormat `json:"content_format"`
+	Rationale           string                 `json:"rationale,omitempty"`
+	Points              float64                `json:"points"`
+	CaseSensitive       bool                   `json:"case_sensitive"`
+	DiacriticsSensitive bool                   `json:"diacritics_sensitive"`
+	Choices             []*Choice              `json:"choices,omitempty"`
+	Pairs               []*Pair                `json:"pairs,omitempty"`
+	AcceptedAnswers     []*AcceptedAnswer      `json:"accepted_answers,omitempty"`
+	NumericValue        *float64               `json:"numeric_value,omitempty"`
+	Tolerance           float64                `json:"tolerance,omitempty"`
+	ToleranceType       constant.ToleranceType `json:"tolerance_type,omitempty"`
+	Units               []string               `json:"units,omitempty"`
+	Tags                []string               `json:"tags"`
+}
+
+type Choice struct {
+	Content         string `json:"content"`
+	IsCorrectAnswer bool   `json:"is_correct_answer"`
+	CorrectPosition int    `json:"correct_position,omitempty"`
+	Explanation     string `json:"explanation,omitempty"`
+}
+
+type Pair struct {
+	LeftContent  string `json:"left_content"`
+	RightContent string `json:"right_content"`
+}
+
+type AcceptedAnswer struct {
+	Content string `json:"content"`
+	IsRegex bool   `json:"is_regex"`
+}
@@ -0,0 +1,173 @@
+package service
+
+import (
+	"context"
+
+	"github.com/arvinpaundra/private-api/domain/bank/constant"
+	"github.com/arvinpaundra/private-api/domain/bank/entity"
+	"github.com/arvinpaundra/private-api/domain/bank/repository"
+	"github.com/arvinpaundra/private-api/domain/shared/interfaces"
+)
+
+type CreateBankQuestionCommand struct {
+	SubjectID string                `json:"subject_id" validate:"required"`
+	GradeID   string                `json:"grade_id" validate:"required"`
+	Type      constant.QuestionType `json:"type" validate:"required,oneof=single_choice multiple_select matching short_answer numeric ordering"`
+	Content   string                `json:"content" validate:"required,max=20000"`
+	Points    float64               `json:"points" validate:"min=0,max=100"`
+	Choices   []*BankQuestionChoice `json:"choices" validate:"omitempty,min=2,max=10,dive"`
+	Pairs     []*BankQuestionPair   `json:"pairs" validate:"omitempty,min=2,max=10,dive"`
+
+	// ContentFormat applies to the question and its choices, plain text when empty
+	ContentFormat constant.ContentFormat `json:"content_format" validate:"omitempty,oneof=plain markdown"`
+
+	// Rationale is shown to students once they have answered
+	Rationale string `json:"rationale" validate:"max=5000"`
+
+	AcceptedAnswers     []*BankQuestionAcceptedAnswer `json:"accepted_answers" validate:"omitempty,min=1,max=10,dive"`
+	CaseSensitive       bool                          `json:"case_sensitive"`
+	DiacriticsSensitive bool                          `json:"diacritics_sensitive"`
+
+	NumericValue  *float64               `json:"numeric_value,omitempty"`
+	Tolerance     float64                `json:"tolerance" validate:"min=0"`
+	ToleranceType constant.ToleranceType `json:"tolerance_type" validate:"omitempty,oneof=absolute percentage"`
+	Units         []string               `json:"units" validate:"omitempty,max=10,dive,required,max=50"`
+
+	Tags []string `json:"tags" validate:"omitempty,max=10,dive,required,max=50"`
+}
+
+type BankQuestionChoice struct {
+	Content         string `json:"content" validate:"required,max=5000"`
+	IsCorrectAnswer bool   `json:"is_correct_answer"`
+	CorrectPosition int    `json:"correct_position" validate:"min=0"`
+	Explanation     string `json:"explanation" validate:"max=2000"`
+}
+
+type BankQuestionPair struct {
+	LeftContent  string `json:"left_content" validate:"required,max=2000"`
+	RightContent string `json:"right_content" validate:"required,max=2000"`
+}
+
+type BankQuestionAcceptedAnswer struct {
+	Content string `json:"content" validate:"required,max=255"`
+	IsRegex bool   `json:"is_regex"`
+}
+
+type CreateBankQuestion struct {
+	authStorage        interfaces.AuthenticatedUser
+	bankQuestionWriter repository.BankQuestionWriter
+	subjectACL         repository.SubjectACL
+	gradeACL           repository.GradeACL
+	moduleACL          repository.ModuleACL
+}
+
+func NewCreateBankQuestion(
+	authStorage interfaces.AuthenticatedUser,
+	bankQuestionWriter repository.BankQuestionWriter,
+	subjectACL repository.SubjectACL,
+	gradeACL repository.GradeACL,
+	moduleACL repository.ModuleACL,
+) *CreateBankQuestion {
+	return &CreateBankQuestion{
+		authStorage:        authStorage,
+		bankQuestionWriter: bankQuestionWriter,
+		subjectACL:         subjectACL,
+		gradeACL:           gradeACL,
+		moduleACL:          moduleACL,
+	}
+}
+
+func (s *CreateBankQuestion) Execute(ctx context.Context, command *CreateBankQuestionCommand) (string, error) {
+	// check if subject exists via ACL
+	isSubjectExist, err := s.subjectACL.IsSubjectExist(ctx, command.SubjectID, s.authStorage.GetUserId())
+	if err != nil {
+		return "", err
+	}
+
+	if !isSubjectExist {
+		return "", constant.ErrSubjectNotFound
+	}
+
+	// check if grade exists via ACL
+	isGradeExist, err := s.gradeACL.IsGradeExist(ctx, command.GradeID, s.authStorage.GetUserId())
+	if err != nil {
+		return "", err
+	}
+
+	if !isGradeExist {
+		return "", constant.ErrGradeNotFound
+	}
+
+	question := entity.NewBankQuestion(
+		s.authStorage.GetUserId(),
+		command.SubjectID,
+		command.GradeID,
+		command.Content,
+		command.ContentFormat,
+		command.Type,
+	)
+
+	addAnswers(question, command)
+
+	question.UpdateTags(command.Tags)
+
+	// Bank questions follow the same answer rules as module questions
+	err = s.moduleACL.ValidateQuestion(ctx, question)
+	if err != nil {
+		return "", err
+	}
+
+	// Store bank question to persistent storage
+	err = s.bankQuestionWriter.Save(ctx, question)
+	if err != nil {
+		return "", err
+	}
+
+	return question.ID, nil
+}
+
+// addAnswers attaches the answers and points described by the command to the bank question
+func addAnswers(question *entity.BankQuestion, command *CreateBankQuestionCommand) {
+	// a question is worth one point unless told otherwise
+	points := command.Points
+	if points == 0 {
+		points = 1
+	}
+
+	question.UpdatePoints(points)
+	question.UpdateRationale(command.Rationale)
+
+	for _, choice := range command.Choices {
+		correctPosition := 0
+		if question.Type == constant.Ordering {
+			correctPosition = choice.CorrectPosition
+		}
+
+		question.AddChoice(
+			question.SanitizeContent(choice.Content), choice.IsCorrectAnswer, correctPosition,
+			question.SanitizeContent(choice.Explanation),
+		)
+	}
+
+	for _, pair := range command.Pairs {
+		question.AddPair(question.SanitizeContent(pair.LeftContent), question.SanitizeContent(pair.RightContent))
+	}
+
+	for _, answer := range command.AcceptedAnswers {
+		question.AddAcceptedAnswer(answer.Content, answer.IsRegex)
+	}
+
+	question.UpdateMatchRules(command.CaseSensitive, command.DiacriticsSensitive)
+
+	for _, unit := range command.Units {
+		question.AddUnit(unit)
+	}
+
+	// default to an absolute tolerance when none is given
+	toleranceType := command.ToleranceType
+	if toleranceType == "" {
+		toleranceType = constant.AbsoluteTolerance
+	}
+
+	question.UpdateNumericAnswer(command.NumericValue, command.Tolerance, toleranceType)
+}
@@ -0,0 +1,48 @@
+package service
+
+import (
+	"context"
+
+	"github.com/arvinpaundra/private-api/domain/bank/repository"
+	"github.com/arvinpaundra/private-api/domain/shared/interfaces"
+)
+
+type DeleteBankQuestionCommand struct {
+	ID string `validate:"required,uuid"`
+}
+
+type DeleteBankQuestion struct {
+	authStorage        interfaces.AuthenticatedUser
+	bankQuestionReader repository.BankQuestionReader
+	bankQuestionWriter repository.BankQuestionWriter
+}
+
+func NewDeleteBankQuestion(
+	authStorage interfaces.AuthenticatedUser,
+	bankQuestionReader repository.BankQuestionReader,
+	bankQuestionWriter repository.BankQuestionWriter,
+) *DeleteBankQuestion {
+	return &DeleteBankQuestion{
+		authStorage:        authStorage,
+		bankQuestionReader: bankQuestionReader,
+		bankQuestionWriter: bankQuestionWriter,
+	}
+}
+
+func (s *DeleteBankQuestion) Execute(ctx context.Context, command *DeleteBankQuestionCommand) error {
+	// Check if bank question exists and belongs to the user
+	question, err := s.bankQuestionReader.FindByID(ctx, command.ID, s.authStorage.GetUserId())
+	if err != nil {
+		return err
+	}
+
+	// Module questions copied or referenced from it stay as they are
+	question.MarkRemove()
+
+	err = s.bankQuestionWriter.Save(ctx, question)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,86 @@
+package service
+
+import (
+	"context"
+
+	"github.com/arvinpaundra/private-api/core/format"
+	"github.com/arvinpaundra/private-api/domain/bank/repository"
+	"github.com/arvinpaundra/private-api/domain/bank/response"
+	"github.com/arvinpaundra/private-api/domain/shared/interfaces"
+)
+
+type FindAllBankQuestionsCommand struct {
+	Keyword   string `form:"keyword"`
+	SubjectID string `form:"subject_id"`
+	GradeID   string `form:"grade_id"`
+	Tag       string `form:"tag"`
+	Page      int    `form:"page"`
+	PerPage   int    `form:"per_page"`
+}
+
+type FindAllBankQuestionsResponse struct {
+	Questions  []*response.BankQuestion `json:"questions"`
+	Pagination format.Pagination        `json:"pagination"`
+}
+
+type FindAllBankQuestions struct {
+	authStorage        interfaces.AuthenticatedUser
+	bankQuestionReader repository.BankQuestionReader
+}
+
+func NewFindAllBankQuestions(
+	authStorage interfaces.AuthenticatedUser,
+	bankQuestionReader repository.BankQuestionReader,
+) *FindAllBankQuestions {
+	return &FindAllBankQuestions{
+		authStorage:        authStorage,
+		bankQuestionReader: bankQuestionReader,
+	}
+}
+
+func (s *FindAllBankQuestions) Execute(ctx context.Context, command *FindAllBankQuestionsCommand) (*FindAllBankQuestionsResponse, error) {
+	// Validate pagination values
+	page := format.ValidatePage(command.Page)
+	perPage := format.ValidatePerPage(command.PerPage)
+	offset := format.CalculateOffset(command.Page, command.PerPage)
+
+	// Get total count
+	total, err := s.bankQuestionReader.TotalBankQuestions(ctx,
+		s.authStorage.GetUserId(),
+		command.SubjectID,
+		command.GradeID,
+		command.Tag,
+		command.Keyword,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get bank questions
+	questions, err := s.bankQuestionReader.FindAllBankQuestions(ctx,
+		s.authStorage.GetUserId(),
+		command.SubjectID,
+		command.GradeID,
+		command.Tag,
+		command.Keyword,
+		perPage,
+		offset,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*response.BankQuestion, len(questions))
+
+	for i, question := range questions {
+		results[i] = toBankQuestionResponse(question)
+	}
+
+	// Create pagination
+	pagination := format.NewPagination(page, perPage, total)
+
+	return &FindAllBankQuestionsResponse{
+		Questions:  results,
+		Pagination: pagination,
+	}, nil
+}
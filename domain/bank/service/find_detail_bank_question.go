@@ -0,0 +1,90 @@
+package service
+
+import (
+	"context"
+
+	"github.com/arvinpaundra/private-api/domain/bank/entity"
+	"github.com/arvinpaundra/private-api/domain/bank/repository"
+	"github.com/arvinpaundra/private-api/domain/bank/response"
+	"github.com/arvinpaundra/private-api/domain/shared/interfaces"
+)
+
+type FindDetailBankQuestionCommand struct {
+	ID string `validate:"required,uuid"`
+}
+
+type FindDetailBankQuestion struct {
+	authStorage        interfaces.AuthenticatedUser
+	bankQuestionReader repository.BankQuestionReader
+}
+
+func NewFindDetailBankQuestion(
+	authStorage interfaces.AuthenticatedUser,
+	bankQuestionReader repository.BankQuestionReader,
+) *FindDetailBankQuestion {
+	return &FindDetailBankQuestion{
+		authStorage:        authStorage,
+		bankQuestionReader: bankQuestionReader,
+	}
+}
+
+func (s *FindDetailBankQuestion) Execute(ctx context.Context, command *FindDetailBankQuestionCommand) (*response.BankQuestion, error) {
+	question, err := s.bankQuestionReader.FindByID(ctx, command.ID, s.authStorage.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	return toBankQuestionResponse(question), nil
+}
+
+func toBankQuestionResponse(question *entity.BankQuestion) *response.BankQuestion {
+	result := &response.BankQuestion{
+		ID:                  question.ID,
+		SubjectID:           question.SubjectID,
+		GradeID:             question.GradeID,
+		Type:                question.Type,
+		Content:             question.Content,
+		ContentFormat:       question.ContentFormat,
+		Rationale:           question.Rationale,
+		Points:              question.Points,
+		CaseSensitive:       question.CaseSensitive,
+		DiacriticsSensitive: question.DiacriticsSensitive,
+		NumericValue:        question.NumericValue,
+		Tolerance:           question.Tolerance,
+		Units:               question.Units,
+		Tags:                question.Tags,
+	}
+
+	if question.NumericValue != nil {
+		result.ToleranceType = question.ToleranceType
+	}
+
+	for _, choice := range question.Choices {
+		result.Choices = append(result.Choices, &response.Choice{
+			Content:         choice.Content,
+			IsCorrectAnswer: choice.IsCorrectAnswer,
+			CorrectPosition: choice.CorrectPosition,
+			Explanation:     choice.Explanation,
+		})
+	}
+
+	for _, pair := range question.Pairs {
+		result.Pairs = append(result.Pairs, &response.Pair{
+			LeftContent:  pair.LeftContent,
+			RightContent: pair.RightContent,
+		})
+	}
+
+	for _, answer := range question.AcceptedAnswers {
+		result.AcceptedAnswers = append(result.AcceptedAnswers, &response.AcceptedAnswer{
+			Content: answer.Content,
+			IsRegex: answer.IsRegex,
+		})
+	}
+
+	if result.Tags == nil {
+		result.Tags = []string{}
+	}
+
+	return result
+}
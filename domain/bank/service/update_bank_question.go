@@ -0,0 +1,111 @@
+package service
+
+import (
+	"context"
+
+	"github.com/arvinpaundra/private-api/domain/bank/constant"
+	"github.com/arvinpaundra/private-api/domain/bank/repository"
+	"github.com/arvinpaundra/private-api/domain/shared/interfaces"
+)
+
+type UpdateBankQuestionCommand struct {
+	ID string `json:"-" validate:"required,uuid"`
+
+	// Propagate copies the edit into unpublished modules that reference the question
+	Propagate bool `json:"propagate"`
+
+	CreateBankQuestionCommand
+}
+
+type UpdateBankQuestion struct {
+	authStorage        interfaces.AuthenticatedUser
+	bankQuestionReader repository.BankQuestionReader
+	bankQuestionWriter repository.BankQuestionWriter
+	subjectACL         repository.SubjectACL
+	gradeACL           repository.GradeACL
+	moduleACL          repository.ModuleACL
+}
+
+func NewUpdateBankQuestion(
+	authStorage interfaces.AuthenticatedUser,
+	bankQuestionReader repository.BankQuestionReader,
+	bankQuestionWriter repository.BankQuestionWriter,
+	subjectACL repository.SubjectACL,
+	gradeACL repository.GradeACL,
+	moduleACL repository.ModuleACL,
+) *UpdateBankQuestion {
+	return &UpdateBankQuestion{
+		authStorage:        authStorage,
+		bankQuestionReader: bankQuestionReader,
+		bankQuestionWriter: bankQuestionWriter,
+		subjectACL:         subjectACL,
+		gradeACL:           gradeACL,
+		moduleACL:          moduleACL,
+	}
+}
+
+func (s *UpdateBankQuestion) Execute(ctx context.Context, command *UpdateBankQuestionCommand) error {
+	// Check if bank question exists and belongs to the user
+	question, err := s.bankQuestionReader.FindByID(ctx, command.ID, s.authStorage.GetUserId())
+	if err != nil {
+		return err
+	}
+
+	// Only check subject via ACL when it changes
+	if command.SubjectID != question.SubjectID {
+		isSubjectExist, err := s.subjectACL.IsSubjectExist(ctx, command.SubjectID, s.authStorage.GetUserId())
+		if err != nil {
+			return err
+		}
+
+		if !isSubjectExist {
+			return constant.ErrSubjectNotFound
+		}
+	}
+
+	// Only check grade via ACL when it changes
+	if command.GradeID != question.GradeID {
+		isGradeExist, err := s.gradeACL.IsGradeExist(ctx, command.GradeID, s.authStorage.GetUserId())
+		if err != nil {
+			return err
+		}
+
+		if !isGradeExist {
+			return constant.ErrGradeNotFound
+		}
+	}
+
+	// Replace question detail, answers and tags
+	question.UpdateDetail(command.SubjectID, command.GradeID, command.Content, command.ContentFormat, command.Type)
+	question.ClearAnswers()
+
+	addAnswers(question, &command.CreateBankQuestionCommand)
+
+	question.UpdateTags(command.Tags)
+
+	err = s.moduleACL.ValidateQuestion(ctx, question)
+	if err != nil {
+		return err
+	}
+
+	// The linked modules must take the edit before anything is saved, or they fall out of sync
+	if command.Propagate {
+		err = s.moduleACL.ValidateLinkedQuestions(ctx, question, s.authStorage.GetUserId())
+		if err != nil {
+			return err
+		}
+	}
+
+	// Store updated bank question to persistent storage
+	err = s.bankQuestionWriter.Save(ctx, question)
+	if err != nil {
+		return err
+	}
+
+	if !command.Propagate {
+		return nil
+	}
+
+	// Only unpublished modules follow the edit, published ones keep what students were given
+	return s.moduleACL.SyncLinkedQuestions(ctx, question, s.authStorage.GetUserId())
+}
@@ -11,6 +11,7 @@ type GradeReader interface {
 	HasSimilarGradeExclusive(ctx context.Context, name string, userID string, excludeGradeID string) (bool, error)
 	IsGradeExist(ctx context.Context, gradeID string, userID string) (bool, error)
 	FindGradeByID(ctx context.Context, gradeID string, userID string) (*entity.Grade, error)
+	FindGradeByName(ctx context.Context, name string, userID string) (*entity.Grade, error)
 	CountByUserID(ctx context.Context, userID string) (int, error)
 	AllGrades(ctx context.Context, userID string, keyword string) ([]*entity.Grade, error)
 }
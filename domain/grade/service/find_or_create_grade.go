@@ -0,0 +1,55 @@
+package service
+
+import (
+	"context"
+
+	"github.com/arvinpaundra/private-api/domain/grade/constant"
+	"github.com/arvinpaundra/private-api/domain/grade/entity"
+	"github.com/arvinpaundra/private-api/domain/grade/repository"
+	"github.com/arvinpaundra/private-api/domain/shared/interfaces"
+)
+
+type FindOrCreateGradeCommand struct {
+	Name string `validate:"required,max=100"`
+}
+
+type FindOrCreateGrade struct {
+	authStorage interfaces.AuthenticatedUser
+	gradeReader repository.GradeReader
+	gradeWriter repository.GradeWriter
+}
+
+func NewFindOrCreateGrade(
+	authStorage interfaces.AuthenticatedUser,
+	gradeReader repository.GradeReader,
+	gradeWriter repository.GradeWriter,
+) *FindOrCreateGrade {
+	return &FindOrCreateGrade{
+		authStorage: authStorage,
+		gradeReader: gradeReader,
+		gradeWriter: gradeWriter,
+	}
+}
+
+// Execute returns the ID of the user's grade with the given name, creating the grade when there is none
+func (s *FindOrCreateGrade) Execute(ctx context.Context, command *FindOrCreateGradeCommand) (string, error) {
+	grade, err := s.gradeReader.FindGradeByName(ctx, command.Name, s.authStorage.GetUserId())
+	if err == nil {
+		return grade.ID, nil
+	}
+
+	if err != constant.ErrGradeNotFound {
+		return "", err
+	}
+
+	// Create grade
+	grade = entity.NewGrade(s.authStorage.GetUserId(), command.Name, nil)
+
+	// Store grade to persistent storage
+	err = s.gradeWriter.Save(ctx, grade)
+	if err != nil {
+		return "", err
+	}
+
+	return grade.ID, nil
+}
@@ -0,0 +1,20 @@
+package constant
+
+import "time"
+
+const (
+	MaxAttachmentSize         = 5 << 20 // 5 MB
+	MaxAttachmentsPerQuestion = 5
+
+	// AttachmentURLExpiry is how long a signed attachment link stays valid
+	AttachmentURLExpiry = 15 * time.Minute
+)
+
+// AttachmentTypes maps the content types accepted for attachments to the file extension they are stored with
+var AttachmentTypes = map[string]string{
+	"image/png":       ".png",
+	"image/jpeg":      ".jpg",
+	"image/gif":       ".gif",
+	"image/webp":      ".webp",
+	"application/pdf": ".pdf",
+}
@@ -5,14 +5,79 @@ import "errors"
 var (
 	ErrModuleNotFound   = errors.New("module not found")
 	ErrQuestionNotFound = errors.New("question not found")
-	ErrChoiceNotFound   = errors.New("choice not found")
+	ErrVersionNotFound  = errors.New("module version not found")
+	ErrVersionMismatch  = errors.New("version does not match the version the submission started on")
+
+	ErrInvalidSchedule = errors.New("closes_at must be after opens_at")
+	ErrModuleNotOpen   = errors.New("module is not open yet")
+	ErrModuleClosed    = errors.New("module is closed")
+
+	// Context mapping errors - module's perspective on submissions
+	ErrSubmissionNotFound    = errors.New("submission not found")
+	ErrSubmissionRequired    = errors.New("submission code is required, the module draws or times every submission")
+	ErrSubmissionAlreadyDone = errors.New("submission already submitted or canceled")
+	ErrSubmissionExpired     = errors.New("submission time is up, it was finalized on the answers given")
+
+	ErrInvalidQuestionOrder = errors.New("question order must list every question of the module exactly once")
+	ErrChoiceNotFound       = errors.New("choice not found")
+
+	ErrQuestionContentTooLong = errors.New("question content must not be longer than 1000 characters once rendered")
+	ErrChoiceContentTooLong   = errors.New("choice content must not be longer than 500 characters once rendered")
+	ErrPairContentTooLong     = errors.New("pair content must not be longer than 255 characters once rendered")
 
 	ErrMinTwoChoices          = errors.New("a question must have at least two choices")
 	ErrMaxFourChoices         = errors.New("a question must not have more than four choices")
 	ErrMultipleCorrectAnswers = errors.New("a question must not have more than one correct answer")
 	ErrNoCorrectAnswer        = errors.New("a question must have at least one correct answer")
+	ErrChoicesNotAllowed      = errors.New("this question type must not have choices")
+	ErrQuestionTypeNotAllowed = errors.New("question type is not allowed for this module type")
+
+	ErrMinTwoPairs       = errors.New("a matching question must have at least two pairs")
+	ErrMaxTenPairs       = errors.New("a matching question must not have more than ten pairs")
+	ErrDuplicatePairItem = errors.New("a matching question must not repeat the same left or right item")
+	ErrPairsNotAllowed   = errors.New("this question type must not have pairs")
+
+	ErrMinOneAcceptedAnswer      = errors.New("a short answer question must have at least one accepted answer")
+	ErrMaxTenAcceptedAnswers     = errors.New("a short answer question must not have more than ten accepted answers")
+	ErrInvalidAnswerPattern      = errors.New("accepted answer is not a valid regular expression")
+	ErrAcceptedAnswersNotAllowed = errors.New("this question type must not have accepted answers")
+
+	ErrNumericValueRequired = errors.New("a numeric question must have a value")
+	ErrNegativeTolerance    = errors.New("tolerance must not be negative")
+	ErrMaxTenUnits          = errors.New("a numeric question must not have more than ten units")
+	ErrDuplicateUnit        = errors.New("a numeric question must not repeat the same unit")
+	ErrUnitsNotAllowed      = errors.New("this question type must not have units")
+
+	ErrMinTwoItems             = errors.New("an ordering question must have at least two items")
+	ErrMaxTenItems             = errors.New("an ordering question must not have more than ten items")
+	ErrInvalidCorrectOrder     = errors.New("correct positions must number the items from 1 without gaps or repeats")
+	ErrCorrectAnswerNotAllowed = errors.New("an ordering question must not mark a correct answer")
+
+	ErrAttachmentNotFound        = errors.New("attachment not found")
+	ErrUnsupportedAttachmentType = errors.New("attachment must be a png, jpeg, gif, webp or pdf file")
+	ErrAttachmentTooLarge        = errors.New("attachment must not be larger than 5 MB")
+	ErrMaxFiveAttachments        = errors.New("a question must not have more than five attachments")
+
+	ErrImportFileTooLarge    = errors.New("import file must not be larger than 2 MB")
+	ErrUnsupportedImportFile = errors.New("import file must be a csv, xlsx, gift or aiken file")
+	ErrUnreadableImportFile  = errors.New("import file could not be read")
+	ErrMissingImportColumns  = errors.New("import file must start with a header row naming the content, choice and correct columns")
+	ErrEmptyImport           = errors.New("import file has no questions")
+	ErrMaxImportRows         = errors.New("import file must not have more than 500 questions")
+	ErrInvalidImportRows     = errors.New("import file has invalid questions, nothing was imported")
+
+	ErrUnsupportedSchemaVersion = errors.New("export was made with a newer schema version and cannot be imported")
+	ErrUnexportableQuestions    = errors.New("some questions cannot be written in this format, nothing was exported")
+
+	ErrModuleNotPublished    = errors.New("module must be published before it can be exported as a qti package")
+	ErrNoExportableQuestions = errors.New("published version has no single choice or multiple select question to export")
+	ErrPackageTooLarge       = errors.New("package must not be larger than 10 MB")
+	ErrInvalidPackage        = errors.New("file is not a valid qti 2.1 content package")
+	ErrNoImportableItems     = errors.New("package has no choice item that can be imported, nothing was imported")
 
 	// Context mapping errors - module's perspective on related entities
 	ErrSubjectNotFound = errors.New("subject not found")
 	ErrGradeNotFound   = errors.New("grade not found")
+
+	ErrBankQuestionNotFound = errors.New("bank question not found")
 )
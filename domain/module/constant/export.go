@@ -0,0 +1,5 @@
+package constant
+
+// ExportSchemaVersion is written to every export. Bump it whenever the document changes shape
+// and keep ImportModule reading the older versions.
+const ExportSchemaVersion = 5
@@ -0,0 +1,18 @@
+package constant
+
+type FileFormat string
+
+const (
+	CSVFile   FileFormat = "csv"
+	XLSXFile  FileFormat = "xlsx"
+	JSONFile  FileFormat = "json"
+	GIFTFile  FileFormat = "gift"  // Moodle GIFT
+	AikenFile FileFormat = "aiken" // Moodle Aiken
+	QTIFile   FileFormat = "qti"   // IMS QTI 2.1 content package
+)
+
+const (
+	MaxImportFileSize = 2 << 20 // 2 MB
+	MaxImportRows     = 500
+	MaxPackageSize    = 10 << 20 // 10 MB, packages may carry images
+)
@@ -0,0 +1,41 @@
+package constant
+
+type QuestionType string
+
+const (
+	SingleChoice   QuestionType = "single_choice"
+	MultipleSelect QuestionType = "multiple_select"
+	Matching       QuestionType = "matching"
+	ShortAnswer    QuestionType = "short_answer"
+	Numeric        QuestionType = "numeric"
+	Ordering       QuestionType = "ordering"
+)
+
+type ContentFormat string
+
+const (
+	PlainText ContentFormat = "plain"
+	Markdown  ContentFormat = "markdown" // markdown with LaTeX math between $…$ or $$…$$
+)
+
+// Content limits count the characters a student reads, not the markup used to write them
+const (
+	MaxQuestionContentLength = 1000
+	MaxChoiceContentLength   = 500
+	MaxPairContentLength     = 255
+)
+
+type ToleranceType string
+
+const (
+	AbsoluteTolerance   ToleranceType = "absolute"
+	PercentageTolerance ToleranceType = "percentage"
+)
+
+type ScoringPolicy string
+
+const (
+	AllOrNothing    ScoringPolicy = "all_or_nothing"
+	Proportional    ScoringPolicy = "proportional"
+	RightMinusWrong ScoringPolicy = "right_minus_wrong"
+)
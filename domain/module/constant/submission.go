@@ -0,0 +1,9 @@
+package constant
+
+// SubmissionStatus is the module's view of where a submission stands
+type SubmissionStatus string
+
+const (
+	SubmissionInProgress SubmissionStatus = "inprogress"
+	SubmissionExpired    SubmissionStatus = "expired"
+)
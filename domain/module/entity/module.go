@@ -1,6 +1,8 @@
 package entity
 
 import (
+	"time"
+
 	"github.com/arvinpaundra/private-api/core/trait"
 	"github.com/arvinpaundra/private-api/core/util"
 	"github.com/arvinpaundra/private-api/domain/module/constant"
@@ -11,29 +13,75 @@ type Module struct {
 	trait.Updateable
 	trait.Removeable
 
-	ID          string
-	UserID      string
-	SubjectID   string
-	GradeID     string
-	Title       string
-	Slug        string
-	Description *string
-	Type        constant.ModuleType
-	IsPublished bool
+	ID            string
+	UserID        string
+	SubjectID     string
+	GradeID       string
+	Title         string
+	Slug          string
+	Description   *string
+	Type          constant.ModuleType
+	ScoringPolicy constant.ScoringPolicy
+	IsPublished   bool
+
+	// WrongAnswerPenalty is the fraction of a question's points taken off for a completely wrong answer
+	WrongAnswerPenalty float64
+
+	// ShuffleQuestions serves every submission the questions in its own order
+	ShuffleQuestions bool
+
+	// ShuffleChoices serves every submission the choices of each question in its own order
+	ShuffleChoices bool
+
+	// DrawCount is how many questions every submission draws at random, 0 serves them all
+	DrawCount int
+
+	// StratifyDraw draws from every question tag in proportion to its share of the questions
+	StratifyDraw bool
+
+	// TimeLimitMinutes is how long every submission runs before it expires, 0 leaves it untimed
+	TimeLimitMinutes int
+
+	// PublishedVersion is the version students are served, 0 until the module is first published
+	PublishedVersion int
+
+	// OpensAt and ClosesAt bound when students can take the published module, nil leaves that end open
+	OpensAt  *time.Time
+	ClosesAt *time.Time
 
 	Questions []*Question
+
+	// Version is set when publishing freezes a new version of the questions
+	Version *ModuleVersion
+}
+
+// ModuleSettings are the delivery settings a module starts with, the zero value serves every question in order, untimed and without penalty
+type ModuleSettings struct {
+	WrongAnswerPenalty float64
+	ShuffleQuestions   bool
+	ShuffleChoices     bool
+	DrawCount          int
+	StratifyDraw       bool
+	TimeLimitMinutes   int
 }
 
-func NewModule(userID, subjectID, gradeID, title string, description *string) (*Module, error) {
+func NewModule(userID, subjectID, gradeID, title string, description *string, moduleType constant.ModuleType, scoringPolicy constant.ScoringPolicy, settings ModuleSettings) (*Module, error) {
 	module := &Module{
-		ID:          util.GenerateUUID(),
-		UserID:      userID,
-		SubjectID:   subjectID,
-		GradeID:     gradeID,
-		Title:       title,
-		Description: description,
-		Type:        constant.MultipleChoice,
-		IsPublished: false,
+		ID:                 util.GenerateUUID(),
+		UserID:             userID,
+		SubjectID:          subjectID,
+		GradeID:            gradeID,
+		Title:              title,
+		Description:        description,
+		Type:               moduleType,
+		ScoringPolicy:      scoringPolicy,
+		IsPublished:        false,
+		WrongAnswerPenalty: settings.WrongAnswerPenalty,
+		ShuffleQuestions:   settings.ShuffleQuestions,
+		ShuffleChoices:     settings.ShuffleChoices,
+		DrawCount:          settings.DrawCount,
+		StratifyDraw:       settings.StratifyDraw,
+		TimeLimitMinutes:   settings.TimeLimitMinutes,
 	}
 
 	err := module.GenSlug()
@@ -46,6 +94,17 @@ func NewModule(userID, subjectID, gradeID, title string, description *string) (*
 	return module, nil
 }
 
+func (m *Module) Settings() ModuleSettings {
+	return ModuleSettings{
+		WrongAnswerPenalty: m.WrongAnswerPenalty,
+		ShuffleQuestions:   m.ShuffleQuestions,
+		ShuffleChoices:     m.ShuffleChoices,
+		DrawCount:          m.DrawCount,
+		StratifyDraw:       m.StratifyDraw,
+		TimeLimitMinutes:   m.TimeLimitMinutes,
+	}
+}
+
 func (m *Module) GenSlug() error {
 	slug, err := util.RandomAlphanumeric(12)
 	if err != nil {
@@ -57,7 +116,120 @@ func (m *Module) GenSlug() error {
 	return nil
 }
 
+// Clone deep-copies the module and its questions into a new unpublished, unscheduled module
+func (m *Module) Clone(userID, subjectID, gradeID, title string) (*Module, error) {
+	module, err := NewModule(userID, subjectID, gradeID, title, m.Description, m.Type, m.ScoringPolicy, m.Settings())
+	if err != nil {
+		return nil, err
+	}
+
+	for _, question := range m.Questions {
+		if question.IsRemoved() {
+			continue
+		}
+
+		clone, err := question.Clone(module.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		module.Questions = append(module.Questions, clone)
+	}
+
+	return module, nil
+}
+
+func (m *Module) UpdateDetail(subjectID, gradeID, title string, description *string) {
+	m.SubjectID = subjectID
+	m.GradeID = gradeID
+	m.Title = title
+	m.Description = description
+	m.MarkUpdate()
+}
+
+func (m *Module) UpdateWrongAnswerPenalty(penalty float64) {
+	m.WrongAnswerPenalty = penalty
+	m.MarkUpdate()
+}
+
+func (m *Module) UpdateShuffleQuestions(shuffle bool) {
+	m.ShuffleQuestions = shuffle
+	m.MarkUpdate()
+}
+
+func (m *Module) UpdateShuffleChoices(shuffle bool) {
+	m.ShuffleChoices = shuffle
+	m.MarkUpdate()
+}
+
+func (m *Module) UpdateDraw(count int, stratify bool) {
+	m.DrawCount = count
+	m.StratifyDraw = stratify
+	m.MarkUpdate()
+}
+
+func (m *Module) UpdateTimeLimit(minutes int) {
+	m.TimeLimitMinutes = minutes
+	m.MarkUpdate()
+}
+
+// IsTimed reports whether every submission runs against a time limit
+func (m *Module) IsTimed() bool {
+	return m.TimeLimitMinutes > 0
+}
+
+// Schedule sets when students can take the module, closesAt must come after opensAt when both are set
+func (m *Module) Schedule(opensAt, closesAt *time.Time) error {
+	if opensAt != nil && closesAt != nil && !closesAt.After(*opensAt) {
+		return constant.ErrInvalidSchedule
+	}
+
+	m.OpensAt = opensAt
+	m.ClosesAt = closesAt
+
+	return nil
+}
+
+func (m *Module) UpdateSchedule(opensAt, closesAt *time.Time) error {
+	err := m.Schedule(opensAt, closesAt)
+	if err != nil {
+		return err
+	}
+
+	m.MarkUpdate()
+
+	return nil
+}
+
+// Availability tells whether students can take the module at the given time
+func (m *Module) Availability(now time.Time) error {
+	if m.OpensAt != nil && now.Before(*m.OpensAt) {
+		return constant.ErrModuleNotOpen
+	}
+
+	if m.ClosesAt != nil && !now.Before(*m.ClosesAt) {
+		return constant.ErrModuleClosed
+	}
+
+	return nil
+}
+
+// Publish serves the last published version again, or freezes the first one when there is none.
+// The questions must be loaded when the module has never been published.
 func (m *Module) Publish() {
+	if m.PublishedVersion == 0 {
+		m.PublishNewVersion()
+		return
+	}
+
+	m.IsPublished = true
+	m.MarkUpdate()
+}
+
+// PublishNewVersion freezes the current questions as a new version and serves it from now on
+func (m *Module) PublishNewVersion() {
+	m.Version = NewModuleVersion(m.ID, m.PublishedVersion+1, m.Settings(), m.Questions)
+	m.PublishedVersion = m.Version.Version
 	m.IsPublished = true
 	m.MarkUpdate()
 }
@@ -71,3 +243,92 @@ func (m *Module) AddQuestion(question *Question) {
 	m.Questions = append(m.Questions, question)
 	m.MarkUpdate()
 }
+
+// FindQuestion returns the module question with the given ID
+func (m *Module) FindQuestion(questionID string) (*Question, error) {
+	for _, question := range m.Questions {
+		if question.ID == questionID && !question.IsRemoved() {
+			return question, nil
+		}
+	}
+
+	return nil, constant.ErrQuestionNotFound
+}
+
+func (m *Module) RemoveQuestion(questionID string) error {
+	question, err := m.FindQuestion(questionID)
+	if err != nil {
+		return err
+	}
+
+	question.MarkRemove()
+	m.MarkUpdate()
+
+	return nil
+}
+
+// NextQuestionPosition is the position a question appended to the end of the module gets
+func (m *Module) NextQuestionPosition() int {
+	position := 0
+
+	for _, question := range m.Questions {
+		if question != nil && !question.IsRemoved() && question.Position > position {
+			position = question.Position
+		}
+	}
+
+	return position + 1
+}
+
+// ReorderQuestions sets the question positions to follow the given IDs, which must cover every question once
+func (m *Module) ReorderQuestions(questionIDs []string) error {
+	if len(questionIDs) != len(m.Questions) {
+		return constant.ErrInvalidQuestionOrder
+	}
+
+	positions := make(map[string]int, len(questionIDs))
+	for i, questionID := range questionIDs {
+		if _, exists := positions[questionID]; exists {
+			return constant.ErrInvalidQuestionOrder
+		}
+
+		positions[questionID] = i + 1
+	}
+
+	for _, question := range m.Questions {
+		position, exists := positions[question.ID]
+		if !exists {
+			return constant.ErrInvalidQuestionOrder
+		}
+
+		if question.Position != position {
+			question.UpdatePosition(position)
+		}
+	}
+
+	m.MarkUpdate()
+
+	return nil
+}
+
+func (m *Module) IsMatchingType() bool {
+	return m.Type == constant.MatchingType
+}
+
+// DefaultQuestionType is the question type used when none is given
+func (m *Module) DefaultQuestionType() constant.QuestionType {
+	if m.IsMatchingType() {
+		return constant.Matching
+	}
+
+	return constant.SingleChoice
+}
+
+// ValidateQuestion checks the question answers against the shape required by the module type
+func (m *Module) ValidateQuestion(question *Question) error {
+	if m.IsMatchingType() != question.IsMatching() {
+		return constant.ErrQuestionTypeNotAllowed
+	}
+
+	return question.Validate()
+}
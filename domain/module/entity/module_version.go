@@ -0,0 +1,232 @@
+package entity
+
+import (
+	"slices"
+
+	"github.com/arvinpaundra/private-api/core/trait"
+	"github.com/arvinpaundra/private-api/core/util"
+	"github.com/arvinpaundra/private-api/domain/module/constant"
+)
+
+// ModuleVersion is a frozen copy of the module questions, taken when a version is published.
+// Students are served and graded from a version, so editing the draft never affects them.
+type ModuleVersion struct {
+	trait.Createable
+
+	ID       string
+	ModuleID string
+	Version  int
+
+	// WrongAnswerPenalty is frozen with the questions, answers are graded with the penalty of their version
+	WrongAnswerPenalty float64
+
+	// DrawCount and StratifyDraw are frozen with the questions, so a version only ever draws one way
+	DrawCount    int
+	StratifyDraw bool
+
+	Questions []*Question
+}
+
+func NewModuleVersion(moduleID string, version int, settings ModuleSettings, questions []*Question) *ModuleVersion {
+	snapshot := make([]*Question, 0, len(questions))
+
+	for _, question := range questions {
+		if question != nil && !question.IsRemoved() {
+			snapshot = append(snapshot, question)
+		}
+	}
+
+	// Questions are frozen in the order they are served
+	slices.SortStableFunc(snapshot, func(a, b *Question) int {
+		return a.Position - b.Position
+	})
+
+	moduleVersion := &ModuleVersion{
+		ID:                 util.GenerateUUID(),
+		ModuleID:           moduleID,
+		Version:            version,
+		WrongAnswerPenalty: settings.WrongAnswerPenalty,
+		DrawCount:          settings.DrawCount,
+		StratifyDraw:       settings.StratifyDraw,
+		Questions:          snapshot,
+	}
+
+	moduleVersion.MarkCreate()
+
+	return moduleVersion
+}
+
+func (v *ModuleVersion) FindQuestionBySlug(slug string) (*Question, error) {
+	for _, question := range v.Questions {
+		if question.Slug == slug {
+			return question, nil
+		}
+	}
+
+	return nil, constant.ErrQuestionNotFound
+}
+
+// Draw picks the questions a new submission is served, in the version order: all of them unless
+// the module draws fewer, otherwise DrawCount at random, from every tag in proportion when stratified.
+// The same seed always draws the same questions.
+func (v *ModuleVersion) Draw(seed int64) []*Question {
+	if !v.DrawsQuestions() {
+		return v.Questions
+	}
+
+	groups := [][]*Question{v.Questions}
+	if v.StratifyDraw {
+		groups = v.questionsByTag()
+	}
+
+	drawn := make(map[string]bool, v.DrawCount)
+
+	for i, count := range drawCounts(v.DrawCount, len(v.Questions), groups) {
+		group := slices.Clone(groups[i])
+
+		util.SeededShuffle(seed, len(group), func(i, j int) {
+			group[i], group[j] = group[j], group[i]
+		})
+
+		for _, question := range group[:count] {
+			drawn[question.ID] = true
+		}
+	}
+
+	questions := make([]*Question, 0, v.DrawCount)
+
+	for _, question := range v.Questions {
+		if drawn[question.ID] {
+			questions = append(questions, question)
+		}
+	}
+
+	return questions
+}
+
+// DrawsQuestions reports whether submissions are served fewer questions than the version holds
+func (v *ModuleVersion) DrawsQuestions() bool {
+	return v.DrawCount > 0 && v.DrawCount < len(v.Questions)
+}
+
+// DrawSize is how many questions every submission is served
+func (v *ModuleVersion) DrawSize() int {
+	if v.DrawsQuestions() {
+		return v.DrawCount
+	}
+
+	return len(v.Questions)
+}
+
+// questionsByTag groups the questions by tag, in the order the tags first appear
+func (v *ModuleVersion) questionsByTag() [][]*Question {
+	var groups [][]*Question
+
+	indexes := make(map[string]int)
+
+	for _, question := range v.Questions {
+		i, exists := indexes[question.Tag]
+		if !exists {
+			i = len(groups)
+			indexes[question.Tag] = i
+			groups = append(groups, nil)
+		}
+
+		groups[i] = append(groups[i], question)
+	}
+
+	return groups
+}
+
+// drawCounts shares the draw between the groups in proportion to their size. Rounding leftovers
+// go to the groups with the largest remainders, the earlier group first on a tie.
+func drawCounts(draw, total int, groups [][]*Question) []int {
+	counts := make([]int, len(groups))
+	remainders := make([]int, len(groups))
+	left := draw
+
+	for i, group := range groups {
+		counts[i] = draw * len(group) / total
+		remainders[i] = draw * len(group) % total
+		left -= counts[i]
+	}
+
+	order := make([]int, len(groups))
+	for i := range order {
+		order[i] = i
+	}
+
+	slices.SortStableFunc(order, func(a, b int) int {
+		return remainders[b] - remainders[a]
+	})
+
+	for _, i := range order[:left] {
+		counts[i]++
+	}
+
+	return counts
+}
+
+// Served returns the questions of a submission given the IDs it drew, all of them when it drew none
+func (v *ModuleVersion) Served(questionIDs []string) []*Question {
+	if len(questionIDs) == 0 {
+		return v.Questions
+	}
+
+	questions := make([]*Question, 0, len(questionIDs))
+
+	for _, question := range v.Questions {
+		if slices.Contains(questionIDs, question.ID) {
+			questions = append(questions, question)
+		}
+	}
+
+	return questions
+}
+
+// NextQuestion returns the question served after the given one among the drawn questions, in the order of the seed,
+// nil when it is the last
+func (v *ModuleVersion) NextQuestion(slug string, seed int64, questionIDs []string) *Question {
+	questions := v.QuestionsFor(seed, questionIDs)
+
+	for i, question := range questions {
+		if question.Slug == slug && i+1 < len(questions) {
+			return questions[i+1]
+		}
+	}
+
+	return nil
+}
+
+// QuestionsFor returns the drawn questions in the order a submission is served them: as frozen
+// when the seed is 0, otherwise shuffled by the seed so a submission always sees the same order
+func (v *ModuleVersion) QuestionsFor(seed int64, questionIDs []string) []*Question {
+	questions := v.Served(questionIDs)
+	if seed == 0 {
+		return questions
+	}
+
+	questions = slices.Clone(questions)
+
+	util.SeededShuffle(seed, len(questions), func(i, j int) {
+		questions[i], questions[j] = questions[j], questions[i]
+	})
+
+	return questions
+}
+
+// ChoiceSeed is the seed ordering the choices of a question for a submission, 0 when there is
+// no submission or it keeps the stored order. It is derived from the submission code so it needs no storing.
+func (v *ModuleVersion) ChoiceSeed(submission *Submission, questionSlug string) int64 {
+	if submission == nil || !submission.ShuffleChoices {
+		return 0
+	}
+
+	return util.SeedOf(submission.Code, questionSlug)
+}
+
+// ItemSeed is the seed shuffling the ordering items and matching right-hand items of a question.
+// They are always shuffled so their position gives nothing away, the same way for a submission on every reload.
+func (v *ModuleVersion) ItemSeed(submissionCode, questionSlug string) int64 {
+	return util.SeedOf(submissionCode, questionSlug)
+}
@@ -0,0 +1,167 @@
+package entity
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+)
+
+// versionOf builds a version holding one question per tag, in the given order
+func versionOf(drawCount int, stratify bool, tags ...string) *ModuleVersion {
+	questions := make([]*Question, len(tags))
+
+	for i, tag := range tags {
+		questions[i] = &Question{
+			ID:       fmt.Sprintf("q%d", i+1),
+			Slug:     fmt.Sprintf("question-%d", i+1),
+			Tag:      tag,
+			Position: i + 1,
+		}
+	}
+
+	return &ModuleVersion{DrawCount: drawCount, StratifyDraw: stratify, Questions: questions}
+}
+
+func idsOf(questions []*Question) []string {
+	ids := make([]string, len(questions))
+
+	for i, question := range questions {
+		ids[i] = question.ID
+	}
+
+	return ids
+}
+
+func countTags(questions []*Question) map[string]int {
+	counts := make(map[string]int)
+
+	for _, question := range questions {
+		counts[question.Tag]++
+	}
+
+	return counts
+}
+
+func TestDrawCounts(t *testing.T) {
+	tests := []struct {
+		draw  int
+		sizes []int
+		want  []int
+	}{
+		{5, []int{5, 5}, []int{3, 2}},
+		{5, []int{3, 3, 4}, []int{2, 1, 2}},
+		{2, []int{1, 1, 1}, []int{1, 1, 0}},
+		{7, []int{6, 3, 1}, []int{4, 2, 1}},
+		{1, []int{9, 1}, []int{1, 0}},
+		{10, []int{10}, []int{10}},
+	}
+
+	for _, tt := range tests {
+		groups := make([][]*Question, len(tt.sizes))
+		total := 0
+
+		for i, size := range tt.sizes {
+			groups[i] = make([]*Question, size)
+			total += size
+		}
+
+		got := drawCounts(tt.draw, total, groups)
+
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("drawCounts(%d, %v) = %v, want %v", tt.draw, tt.sizes, got, tt.want)
+		}
+
+		sum := 0
+		for i, count := range got {
+			sum += count
+
+			if count > tt.sizes[i] {
+				t.Errorf("drawCounts(%d, %v) draws %d from a group of %d", tt.draw, tt.sizes, count, tt.sizes[i])
+			}
+		}
+
+		if sum != tt.draw {
+			t.Errorf("drawCounts(%d, %v) sums to %d, want %d", tt.draw, tt.sizes, sum, tt.draw)
+		}
+	}
+}
+
+func TestModuleVersionDraw(t *testing.T) {
+	version := versionOf(4, false, "", "", "", "", "", "", "", "")
+
+	for seed := int64(1); seed <= 50; seed++ {
+		drawn := version.Draw(seed)
+
+		if len(drawn) != 4 {
+			t.Fatalf("Draw(%d) drew %d questions, want 4", seed, len(drawn))
+		}
+
+		// Drawn questions keep the version order
+		if !slices.IsSortedFunc(drawn, func(a, b *Question) int { return a.Position - b.Position }) {
+			t.Errorf("Draw(%d) = %v, not in the version order", seed, idsOf(drawn))
+		}
+	}
+}
+
+func TestModuleVersionDraw_SameSeed(t *testing.T) {
+	version := versionOf(3, true, "algebra", "algebra", "geometry", "geometry", "", "", "algebra", "geometry")
+
+	for seed := int64(1); seed <= 50; seed++ {
+		first, second := idsOf(version.Draw(seed)), idsOf(version.Draw(seed))
+
+		if !slices.Equal(first, second) {
+			t.Errorf("Draw(%d) = %v then %v, want the same draw", seed, first, second)
+		}
+	}
+}
+
+func TestModuleVersionDraw_LargerThanPool(t *testing.T) {
+	for _, drawCount := range []int{0, 3, 10} {
+		version := versionOf(drawCount, true, "algebra", "geometry", "")
+
+		if version.DrawsQuestions() {
+			t.Errorf("DrawsQuestions() with a draw of %d out of 3 = true, want false", drawCount)
+		}
+
+		if version.DrawSize() != 3 {
+			t.Errorf("DrawSize() with a draw of %d out of 3 = %d, want 3", drawCount, version.DrawSize())
+		}
+
+		if got := idsOf(version.Draw(1)); !slices.Equal(got, []string{"q1", "q2", "q3"}) {
+			t.Errorf("Draw(1) with a draw of %d out of 3 = %v, want every question", drawCount, got)
+		}
+	}
+}
+
+func TestModuleVersionDraw_Stratified(t *testing.T) {
+	// Untagged questions are drawn from as a group of their own
+	version := versionOf(5, true, "algebra", "algebra", "algebra", "algebra", "", "", "", "", "geometry", "geometry")
+
+	for seed := int64(1); seed <= 50; seed++ {
+		drawn := version.Draw(seed)
+
+		if len(drawn) != 5 {
+			t.Fatalf("Draw(%d) drew %d questions, want 5", seed, len(drawn))
+		}
+
+		want := map[string]int{"algebra": 2, "": 2, "geometry": 1}
+		if got := countTags(drawn); fmt.Sprint(got) != fmt.Sprint(want) {
+			t.Errorf("Draw(%d) drew %v per tag, want %v", seed, got, want)
+		}
+	}
+}
+
+func TestModuleVersionDraw_NotStratified(t *testing.T) {
+	version := versionOf(2, false, "algebra", "algebra", "algebra", "geometry")
+
+	// Without stratifying, a draw may leave a tag out entirely
+	leftOut := false
+
+	for seed := int64(1); seed <= 200 && !leftOut; seed++ {
+		leftOut = countTags(version.Draw(seed))["geometry"] == 0
+	}
+
+	if !leftOut {
+		t.Errorf("Draw never left out the geometry question over 200 seeds")
+	}
+}
@@ -1,6 +1,10 @@
 package entity
 
 import (
+	"regexp"
+	"slices"
+	"strings"
+
 	"github.com/arvinpaundra/private-api/core/trait"
 	"github.com/arvinpaundra/private-api/core/util"
 	"github.com/arvinpaundra/private-api/domain/module/constant"
@@ -13,19 +17,51 @@ type Question struct {
 
 	ID       string
 	ModuleID string
+	Type     constant.QuestionType
 	Content  string
 	Slug     string
 
-	Choices []*QuestionChoice
+	// ContentFormat tells how the question and its choices are rendered
+	ContentFormat constant.ContentFormat
+
+	// Rationale explains the answer to students once they have answered
+	Rationale string
+
+	// Tag groups questions for a stratified draw, such as a topic or a difficulty
+	Tag string
+
+	Position int
+	Points   float64 // worth of a fully correct answer
+
+	// Matching rules for short answer questions
+	CaseSensitive       bool
+	DiacriticsSensitive bool
+
+	// Expected value for numeric questions
+	NumericValue  *float64
+	Tolerance     float64
+	ToleranceType constant.ToleranceType
+
+	Choices         []*QuestionChoice
+	Pairs           []*QuestionPair
+	AcceptedAnswers []*QuestionAcceptedAnswer
+	Units           []*QuestionUnit
+	Attachments     []*QuestionAttachment
+
+	// BankQuestionID is set when the question follows edits made to a bank question
+	BankQuestionID *string
 }
 
-func NewQuestion(moduleID, content string) (*Question, error) {
+func NewQuestion(moduleID, content string, contentFormat constant.ContentFormat, questionType constant.QuestionType) (*Question, error) {
 	question := &Question{
 		ID:       util.GenerateUUID(),
 		ModuleID: moduleID,
-		Content:  content,
+		Type:     questionType,
+		Points:   1,
 	}
 
+	question.setContent(content, contentFormat)
+
 	err := question.GenSlug()
 	if err != nil {
 		return nil, err
@@ -47,6 +83,93 @@ func (q *Question) GenSlug() error {
 	return nil
 }
 
+// Validate checks that the question carries only the answers its type allows
+func (q *Question) Validate() error {
+	err := q.IsValidContent()
+	if err != nil {
+		return err
+	}
+
+	if q.Type != constant.Numeric && q.HasUnits() {
+		return constant.ErrUnitsNotAllowed
+	}
+
+	switch q.Type {
+	case constant.Matching:
+		if q.HasChoices() {
+			return constant.ErrChoicesNotAllowed
+		} else if q.HasAcceptedAnswers() {
+			return constant.ErrAcceptedAnswersNotAllowed
+		}
+
+		return q.IsValidPairs()
+	case constant.ShortAnswer:
+		if q.HasChoices() {
+			return constant.ErrChoicesNotAllowed
+		} else if q.HasPairs() {
+			return constant.ErrPairsNotAllowed
+		}
+
+		return q.IsValidAcceptedAnswers()
+	case constant.Ordering:
+		if q.HasPairs() {
+			return constant.ErrPairsNotAllowed
+		} else if q.HasAcceptedAnswers() {
+			return constant.ErrAcceptedAnswersNotAllowed
+		}
+
+		return q.IsValidOrderingItems()
+	case constant.Numeric:
+		if q.HasChoices() {
+			return constant.ErrChoicesNotAllowed
+		} else if q.HasPairs() {
+			return constant.ErrPairsNotAllowed
+		} else if q.HasAcceptedAnswers() {
+			return constant.ErrAcceptedAnswersNotAllowed
+		}
+
+		return q.IsValidNumericAnswer()
+	default:
+		if q.HasPairs() {
+			return constant.ErrPairsNotAllowed
+		} else if q.HasAcceptedAnswers() {
+			return constant.ErrAcceptedAnswersNotAllowed
+		}
+
+		return q.IsValidChoices()
+	}
+}
+
+// IsValidContent limits the content by the length students read once it is rendered
+func (q *Question) IsValidContent() error {
+	if util.RenderedLength(q.Content, q.IsMarkdown()) > constant.MaxQuestionContentLength {
+		return constant.ErrQuestionContentTooLong
+	}
+
+	for _, choice := range q.Choices {
+		if choice.IsRemoved() {
+			continue
+		}
+
+		if util.RenderedLength(choice.Content, q.IsMarkdown()) > constant.MaxChoiceContentLength {
+			return constant.ErrChoiceContentTooLong
+		}
+	}
+
+	for _, pair := range q.Pairs {
+		if pair.IsRemoved() {
+			continue
+		}
+
+		if util.RenderedLength(pair.LeftContent, q.IsMarkdown()) > constant.MaxPairContentLength ||
+			util.RenderedLength(pair.RightContent, q.IsMarkdown()) > constant.MaxPairContentLength {
+			return constant.ErrPairContentTooLong
+		}
+	}
+
+	return nil
+}
+
 func (q *Question) IsValidChoices() error {
 	counter := 0
 
@@ -58,8 +181,8 @@ func (q *Question) IsValidChoices() error {
 		}
 
 		if choice.IsCorrectAnswer {
-			// only one correct answer is allowed
-			if hasCorrectAnswer {
+			// only one correct answer is allowed unless the student may select several
+			if hasCorrectAnswer && !q.IsMultipleSelect() {
 				return constant.ErrMultipleCorrectAnswers
 			}
 
@@ -82,12 +205,415 @@ func (q *Question) IsValidChoices() error {
 	return nil
 }
 
+// IsValidOrderingItems checks that the choices carry a complete answer-key order from 1 to N
+func (q *Question) IsValidOrderingItems() error {
+	counter := 0
+
+	positions := make(map[int]bool)
+
+	for _, choice := range q.Choices {
+		if choice.IsRemoved() {
+			continue
+		}
+
+		if choice.IsCorrectAnswer {
+			return constant.ErrCorrectAnswerNotAllowed
+		}
+
+		if positions[choice.CorrectPosition] {
+			return constant.ErrInvalidCorrectOrder
+		}
+
+		positions[choice.CorrectPosition] = true
+
+		counter++
+	}
+
+	if counter < 2 {
+		return constant.ErrMinTwoItems
+	} else if counter > 10 {
+		return constant.ErrMaxTenItems
+	}
+
+	for position := 1; position <= counter; position++ {
+		if !positions[position] {
+			return constant.ErrInvalidCorrectOrder
+		}
+	}
+
+	return nil
+}
+
+func (q *Question) IsValidPairs() error {
+	counter := 0
+
+	lefts := make(map[string]bool)
+	rights := make(map[string]bool)
+
+	for _, pair := range q.Pairs {
+		if pair.IsRemoved() {
+			continue
+		}
+
+		// each side must be unambiguous for the student
+		left := strings.ToLower(strings.TrimSpace(pair.LeftContent))
+		right := strings.ToLower(strings.TrimSpace(pair.RightContent))
+
+		if lefts[left] || rights[right] {
+			return constant.ErrDuplicatePairItem
+		}
+
+		lefts[left] = true
+		rights[right] = true
+
+		counter++
+	}
+
+	if counter < 2 {
+		return constant.ErrMinTwoPairs
+	} else if counter > 10 {
+		return constant.ErrMaxTenPairs
+	}
+
+	return nil
+}
+
+func (q *Question) IsValidAcceptedAnswers() error {
+	counter := 0
+
+	for _, answer := range q.AcceptedAnswers {
+		if answer.IsRemoved() {
+			continue
+		}
+
+		if answer.IsRegex {
+			if _, err := regexp.Compile(answer.Content); err != nil {
+				return constant.ErrInvalidAnswerPattern
+			}
+		}
+
+		counter++
+	}
+
+	if counter < 1 {
+		return constant.ErrMinOneAcceptedAnswer
+	} else if counter > 10 {
+		return constant.ErrMaxTenAcceptedAnswers
+	}
+
+	return nil
+}
+
+func (q *Question) IsValidNumericAnswer() error {
+	if q.NumericValue == nil {
+		return constant.ErrNumericValueRequired
+	}
+
+	if q.Tolerance < 0 {
+		return constant.ErrNegativeTolerance
+	}
+
+	counter := 0
+
+	units := make(map[string]bool)
+
+	for _, unit := range q.Units {
+		if unit.IsRemoved() {
+			continue
+		}
+
+		name := strings.ToLower(strings.TrimSpace(unit.Name))
+		if units[name] {
+			return constant.ErrDuplicateUnit
+		}
+
+		units[name] = true
+
+		counter++
+	}
+
+	if counter > 10 {
+		return constant.ErrMaxTenUnits
+	}
+
+	return nil
+}
+
+func (q *Question) IsMarkdown() bool {
+	return q.ContentFormat == constant.Markdown
+}
+
+func (q *Question) IsMultipleSelect() bool {
+	return q.Type == constant.MultipleSelect
+}
+
+func (q *Question) IsOrdering() bool {
+	return q.Type == constant.Ordering
+}
+
+func (q *Question) IsMatching() bool {
+	return q.Type == constant.Matching
+}
+
+// ChoicesFor returns the choices in the order a submission is served them: as stored when the
+// seed is 0, otherwise shuffled by the seed. Ordering items are left alone, their order is the answer.
+func (q *Question) ChoicesFor(seed int64) []*QuestionChoice {
+	if seed == 0 || q.IsOrdering() {
+		return q.Choices
+	}
+
+	choices := slices.Clone(q.Choices)
+
+	util.SeededShuffle(seed, len(choices), func(i, j int) {
+		choices[i], choices[j] = choices[j], choices[i]
+	})
+
+	return choices
+}
+
+func (q *Question) HasChoices() bool {
+	for _, choice := range q.Choices {
+		if !choice.IsRemoved() {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (q *Question) HasPairs() bool {
+	for _, pair := range q.Pairs {
+		if !pair.IsRemoved() {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (q *Question) HasAcceptedAnswers() bool {
+	for _, answer := range q.AcceptedAnswers {
+		if !answer.IsRemoved() {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (q *Question) HasUnits() bool {
+	for _, unit := range q.Units {
+		if !unit.IsRemoved() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Clone deep-copies the question and its answers under new IDs and a new slug
+func (q *Question) Clone(moduleID string) (*Question, error) {
+	question, err := NewQuestion(moduleID, q.Content, q.ContentFormat, q.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	question.Position = q.Position
+	question.Points = q.Points
+	question.Rationale = q.Rationale
+	question.Tag = q.Tag
+	question.CaseSensitive = q.CaseSensitive
+	question.DiacriticsSensitive = q.DiacriticsSensitive
+	question.NumericValue = q.NumericValue
+	question.Tolerance = q.Tolerance
+	question.ToleranceType = q.ToleranceType
+	question.BankQuestionID = q.BankQuestionID
+
+	for _, choice := range q.Choices {
+		if choice.IsRemoved() {
+			continue
+		}
+
+		clone := NewQuestionChoice(question.ID, choice.Content, choice.Position)
+		clone.IsCorrectAnswer = choice.IsCorrectAnswer
+		clone.CorrectPosition = choice.CorrectPosition
+		clone.Explanation = choice.Explanation
+
+		question.AddChoice(clone)
+	}
+
+	for _, pair := range q.Pairs {
+		if !pair.IsRemoved() {
+			question.AddPair(NewQuestionPair(question.ID, pair.LeftContent, pair.RightContent))
+		}
+	}
+
+	for _, answer := range q.AcceptedAnswers {
+		if !answer.IsRemoved() {
+			question.AddAcceptedAnswer(NewQuestionAcceptedAnswer(question.ID, answer.Content, answer.IsRegex))
+		}
+	}
+
+	for _, unit := range q.Units {
+		if !unit.IsRemoved() {
+			question.AddUnit(NewQuestionUnit(question.ID, unit.Name))
+		}
+	}
+
+	// Stored files are never overwritten, so the copy can share them
+	for _, attachment := range q.Attachments {
+		if !attachment.IsRemoved() {
+			question.AddAttachment(NewQuestionAttachment(
+				question.ID, attachment.ChoicePosition, attachment.StorageKey,
+				attachment.Filename, attachment.ContentType, attachment.Size,
+			))
+		}
+	}
+
+	return question, nil
+}
+
 func (q *Question) AddChoice(choice *QuestionChoice) {
 	q.Choices = append(q.Choices, choice)
 }
 
-func (q *Question) UpdateContent(content string) {
-	q.Content = content
+func (q *Question) AddPair(pair *QuestionPair) {
+	q.Pairs = append(q.Pairs, pair)
+}
+
+func (q *Question) AddAcceptedAnswer(answer *QuestionAcceptedAnswer) {
+	q.AcceptedAnswers = append(q.AcceptedAnswers, answer)
+}
+
+func (q *Question) AddUnit(unit *QuestionUnit) {
+	q.Units = append(q.Units, unit)
+}
+
+func (q *Question) AddAttachment(attachment *QuestionAttachment) {
+	q.Attachments = append(q.Attachments, attachment)
+}
+
+// Attach adds an uploaded file to the question, or to one of its choices when choicePosition is set
+func (q *Question) Attach(attachment *QuestionAttachment) error {
+	if attachment.ChoicePosition > 0 && !q.HasChoiceAt(attachment.ChoicePosition) {
+		return constant.ErrChoiceNotFound
+	}
+
+	counter := 0
+
+	for _, existing := range q.Attachments {
+		if !existing.IsRemoved() {
+			counter++
+		}
+	}
+
+	if counter >= constant.MaxAttachmentsPerQuestion {
+		return constant.ErrMaxFiveAttachments
+	}
+
+	q.AddAttachment(attachment)
+	q.MarkUpdate()
+
+	return nil
+}
+
+func (q *Question) RemoveAttachment(attachmentID string) error {
+	for _, attachment := range q.Attachments {
+		if attachment.ID == attachmentID && !attachment.IsRemoved() {
+			attachment.MarkRemove()
+			q.MarkUpdate()
+
+			return nil
+		}
+	}
+
+	return constant.ErrAttachmentNotFound
+}
+
+// AttachmentsOf returns the attachments of the question itself when choicePosition is 0, otherwise of that choice
+func (q *Question) AttachmentsOf(choicePosition int) []*QuestionAttachment {
+	var attachments []*QuestionAttachment
+
+	for _, attachment := range q.Attachments {
+		if !attachment.IsRemoved() && attachment.ChoicePosition == choicePosition {
+			attachments = append(attachments, attachment)
+		}
+	}
+
+	return attachments
+}
+
+func (q *Question) HasChoiceAt(position int) bool {
+	for _, choice := range q.Choices {
+		if !choice.IsRemoved() && choice.Position == position {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (q *Question) UpdateContent(content string, contentFormat constant.ContentFormat) {
+	q.setContent(content, contentFormat)
+	q.MarkUpdate()
+}
+
+// SanitizeContent cleans choice or pair content the same way as the question content
+func (q *Question) SanitizeContent(content string) string {
+	return util.SanitizeRichText(content, q.IsMarkdown())
+}
+
+// setContent stores the content without anything a browser could run, plain text by default
+func (q *Question) setContent(content string, contentFormat constant.ContentFormat) {
+	if contentFormat == "" {
+		contentFormat = constant.PlainText
+	}
+
+	q.ContentFormat = contentFormat
+	q.Content = util.SanitizeRichText(content, contentFormat == constant.Markdown)
+}
+
+// LinkBankQuestion makes the question follow later edits of the bank question it came from
+func (q *Question) LinkBankQuestion(bankQuestionID string) {
+	q.BankQuestionID = &bankQuestionID
+}
+
+func (q *Question) UpdatePosition(position int) {
+	q.Position = position
+	q.MarkUpdate()
+}
+
+func (q *Question) UpdateRationale(rationale string) {
+	q.Rationale = q.SanitizeContent(rationale)
+	q.MarkUpdate()
+}
+
+// UpdateTag sets the tag lower-cased and trimmed, so "Easy" and "easy " draw from the same group
+func (q *Question) UpdateTag(tag string) {
+	q.Tag = strings.ToLower(strings.TrimSpace(tag))
+	q.MarkUpdate()
+}
+
+func (q *Question) UpdatePoints(points float64) {
+	q.Points = points
+	q.MarkUpdate()
+}
+
+func (q *Question) UpdateType(questionType constant.QuestionType) {
+	q.Type = questionType
+	q.MarkUpdate()
+}
+
+func (q *Question) UpdateMatchRules(caseSensitive, diacriticsSensitive bool) {
+	q.CaseSensitive = caseSensitive
+	q.DiacriticsSensitive = diacriticsSensitive
+	q.MarkUpdate()
+}
+
+func (q *Question) UpdateNumericAnswer(value *float64, tolerance float64, toleranceType constant.ToleranceType) {
+	q.NumericValue = value
+	q.Tolerance = tolerance
+	q.ToleranceType = toleranceType
 	q.MarkUpdate()
 }
 
@@ -97,6 +623,40 @@ func (q *Question) ClearChoices() {
 		choice.MarkRemove()
 	}
 
+	// Choice attachments follow the choice position, they would otherwise land on whichever new choice takes it
+	for _, attachment := range q.Attachments {
+		if attachment.ChoicePosition > 0 {
+			attachment.MarkRemove()
+		}
+	}
+
+	q.MarkUpdate()
+}
+
+func (q *Question) ClearPairs() {
+	// Mark existing pairs for removal before clearing
+	for _, pair := range q.Pairs {
+		pair.MarkRemove()
+	}
+
+	q.MarkUpdate()
+}
+
+func (q *Question) ClearAcceptedAnswers() {
+	// Mark existing accepted answers for removal before clearing
+	for _, answer := range q.AcceptedAnswers {
+		answer.MarkRemove()
+	}
+
+	q.MarkUpdate()
+}
+
+func (q *Question) ClearUnits() {
+	// Mark existing units for removal before clearing
+	for _, unit := range q.Units {
+		unit.MarkRemove()
+	}
+
 	q.MarkUpdate()
 }
 
@@ -109,13 +669,17 @@ type QuestionChoice struct {
 	QuestionID      string
 	Content         string
 	IsCorrectAnswer bool
+	Position        int // order the choice was authored in
+	CorrectPosition int // answer-key order of an ordering item, 0 for other question types
+	Explanation     string
 }
 
-func NewQuestionChoice(questionID, content string) *QuestionChoice {
+func NewQuestionChoice(questionID, content string, position int) *QuestionChoice {
 	choice := &QuestionChoice{
 		ID:         util.GenerateUUID(),
 		QuestionID: questionID,
 		Content:    content,
+		Position:   position,
 	}
 
 	choice.MarkCreate()
@@ -126,3 +690,118 @@ func NewQuestionChoice(questionID, content string) *QuestionChoice {
 func (qc *QuestionChoice) SetAsCorrectAnswer() {
 	qc.IsCorrectAnswer = true
 }
+
+func (qc *QuestionChoice) SetCorrectPosition(position int) {
+	qc.CorrectPosition = position
+}
+
+// SetExplanation tells students why the choice is right or wrong
+func (qc *QuestionChoice) SetExplanation(explanation string) {
+	qc.Explanation = explanation
+}
+
+// QuestionPair links a left item to the right item it must be matched with
+type QuestionPair struct {
+	trait.Createable
+	trait.Updateable
+	trait.Removeable
+
+	ID           string
+	QuestionID   string
+	LeftContent  string
+	RightID      string // kept apart from ID so served items don't reveal the pairing
+	RightContent string
+}
+
+func NewQuestionPair(questionID, leftContent, rightContent string) *QuestionPair {
+	pair := &QuestionPair{
+		ID:           util.GenerateUUID(),
+		QuestionID:   questionID,
+		LeftContent:  leftContent,
+		RightID:      util.GenerateUUID(),
+		RightContent: rightContent,
+	}
+
+	pair.MarkCreate()
+
+	return pair
+}
+
+// QuestionAcceptedAnswer is one text a short answer question accepts, either literal or a regular expression
+type QuestionAcceptedAnswer struct {
+	trait.Createable
+	trait.Updateable
+	trait.Removeable
+
+	ID         string
+	QuestionID string
+	Content    string
+	IsRegex    bool
+}
+
+func NewQuestionAcceptedAnswer(questionID, content string, isRegex bool) *QuestionAcceptedAnswer {
+	answer := &QuestionAcceptedAnswer{
+		ID:         util.GenerateUUID(),
+		QuestionID: questionID,
+		Content:    content,
+		IsRegex:    isRegex,
+	}
+
+	answer.MarkCreate()
+
+	return answer
+}
+
+// QuestionUnit is a unit a numeric answer may be written in, such as "cm" or "kg"
+type QuestionUnit struct {
+	trait.Createable
+	trait.Updateable
+	trait.Removeable
+
+	ID         string
+	QuestionID string
+	Name       string
+}
+
+func NewQuestionUnit(questionID, name string) *QuestionUnit {
+	unit := &QuestionUnit{
+		ID:         util.GenerateUUID(),
+		QuestionID: questionID,
+		Name:       name,
+	}
+
+	unit.MarkCreate()
+
+	return unit
+}
+
+// QuestionAttachment is an uploaded file shown with a question, or with one of its choices.
+// Choices are rebuilt whenever a question is edited, so a choice is referenced by its position.
+type QuestionAttachment struct {
+	trait.Createable
+	trait.Removeable
+
+	ID             string
+	QuestionID     string
+	ChoicePosition int // 0 when the file belongs to the question itself
+	StorageKey     string
+	Filename       string
+	ContentType    string
+	Size           int64
+}
+
+func NewQuestionAttachment(questionID string, choicePosition int, storageKey, filename, contentType string, size int64) *QuestionAttachment {
+	attachment := &QuestionAttachment{
+		ID:             util.GenerateUUID(),
+		QuestionID:     questionID,
+		ChoicePosition: choicePosition,
+		StorageKey:     storageKey,
+		Filename:       filename,
+		ContentType:    contentType,
+		Size:           size,
+	}
+
+	attachment.MarkCreate()
+
+	return attachment
+}
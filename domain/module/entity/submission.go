@@ -0,0 +1,43 @@
+package entity
+
+import (
+	"math"
+	"time"
+
+	"github.com/arvinpaundra/private-api/domain/module/constant"
+)
+
+// Submission is the module's view of a submission: the questions it drew, their order and when its time is up
+type Submission struct {
+	Code           string
+	Status         constant.SubmissionStatus
+	ModuleVersion  int        // the version the submission is served, frozen when it started
+	ShuffleChoices bool       // the choices are served in the submission's own order, frozen when it started
+	QuestionSeed   int64      // 0 when the questions are served in the frozen order
+	QuestionIDs    []string   // nil when the submission is served every question
+	ExpiresAt      *time.Time // nil when the module is untimed
+}
+
+// RemainingSeconds is the time left to answer at the given time, nil when the submission is untimed
+func (s *Submission) RemainingSeconds(now time.Time) *int {
+	if s.ExpiresAt == nil {
+		return nil
+	}
+
+	remaining := max(0, int(math.Ceil(s.ExpiresAt.Sub(now).Seconds())))
+
+	return &remaining
+}
+
+// CheckInProgress tells whether the submission can still be served questions at the given time
+func (s *Submission) CheckInProgress(now time.Time) error {
+	if s.Status == constant.SubmissionExpired || (s.ExpiresAt != nil && !now.Before(*s.ExpiresAt)) {
+		return constant.ErrSubmissionExpired
+	}
+
+	if s.Status != constant.SubmissionInProgress {
+		return constant.ErrSubmissionAlreadyDone
+	}
+
+	return nil
+}
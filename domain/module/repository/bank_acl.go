@@ -0,0 +1,12 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/arvinpaundra/private-api/domain/module/entity"
+)
+
+type BankACL interface {
+	// GetBankQuestion returns the bank question as a module-less question to be cloned into a module
+	GetBankQuestion(ctx context.Context, bankQuestionID string, userID string) (*entity.Question, error)
+}
@@ -8,4 +8,7 @@ type GradeACL interface {
 	IsGradeExist(ctx context.Context, gradeID string, userID string) (bool, error)
 	GetGradeName(ctx context.Context, gradeID string, userID string) (string, error)
 	GetGradeNames(ctx context.Context, gradeIDs []string, userID string) (map[string]string, error)
+
+	// FindOrCreateGrade returns the ID of the user's grade with the given name, creating it when missing
+	FindOrCreateGrade(ctx context.Context, name string, userID string) (string, error)
 }
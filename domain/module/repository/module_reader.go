@@ -10,9 +10,9 @@ type ModuleReader interface {
 	FindByID(ctx context.Context, moduleID, userID string) (*entity.Module, error)
 	FindBySlug(ctx context.Context, slug, userID string) (*entity.Module, error)
 	FindModuleDetailBySlug(ctx context.Context, slug, userID string) (*entity.Module, error)
+	FindUnpublishedModulesLinkedTo(ctx context.Context, bankQuestionID, userID string) ([]*entity.Module, error)
 	FindPublishedModuleBySlug(ctx context.Context, slug string) (*entity.Module, error)
-	FindPublishedQuestionBySlug(ctx context.Context, moduleSlug, questionSlug string) (*entity.Question, error)
-	FindNextPublishedQuestion(ctx context.Context, moduleSlug, currentQuestionSlug string) (*entity.Question, error)
+	FindPublishedVersion(ctx context.Context, moduleSlug string, version int) (*entity.ModuleVersion, error)
 	CountQuestionsByModuleSlug(ctx context.Context, moduleSlug string) (int, error)
 	CountByUserID(ctx context.Context, userID string) (int, error)
 	TotalModules(ctx context.Context, userID, subjectID, gradeID, keyword string) (int, error)
@@ -8,4 +8,7 @@ type SubjectACL interface {
 	IsSubjectExist(ctx context.Context, subjectID string, userID string) (bool, error)
 	GetSubjectName(ctx context.Context, subjectID string, userID string) (string, error)
 	GetSubjectNames(ctx context.Context, subjectIDs []string, userID string) (map[string]string, error)
+
+	// FindOrCreateSubject returns the ID of the user's subject with the given name, creating it when missing
+	FindOrCreateSubject(ctx context.Context, name string, userID string) (string, error)
 }
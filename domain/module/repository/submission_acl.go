@@ -0,0 +1,12 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/arvinpaundra/private-api/domain/module/entity"
+)
+
+type SubmissionACL interface {
+	// GetSubmission returns the questions the submission drew and its deadline
+	GetSubmission(ctx context.Context, moduleID, submissionCode string) (*entity.Submission, error)
+}
@@ -0,0 +1,88 @@
+package response
+
+import (
+	"time"
+
+	"github.com/arvinpaundra/private-api/domain/module/constant"
+)
+
+// ModuleExport is the portable document of a module. Subject and grade travel by name
+// since their IDs mean nothing to another account or environment.
+type ModuleExport struct {
+	SchemaVersion int             `json:"schema_version"`
+	ExportedAt    time.Time       `json:"exported_at"`
+	Module        *ExportedModule `json:"module"`
+}
+
+type ExportedModule struct {
+	Title              string                 `json:"title"`
+	Description        *string                `json:"description"`
+	Subject            string                 `json:"subject"`
+	Grade              string                 `json:"grade"`
+	Type               constant.ModuleType    `json:"type"`
+	ScoringPolicy      constant.ScoringPolicy `json:"scoring_policy"`
+	WrongAnswerPenalty float64                `json:"wrong_answer_penalty"`
+	ShuffleQuestions   bool                   `json:"shuffle_questions"`
+	ShuffleChoices     bool                   `json:"shuffle_choices"`
+	DrawCount          int                    `json:"draw_count" validate:"min=0,max=500"`
+	StratifyDraw       bool                   `json:"stratify_draw"`
+	TimeLimitMinutes   int                    `json:"time_limit_minutes" validate:"min=0,max=1440"`
+	Questions          []*ExportedQuestion    `json:"questions"`
+}
+
+type ExportedQuestion struct {
+	Type                constant.QuestionType     `json:"type"`
+	Content             string                    `json:"content"`
+	ContentFormat       constant.ContentFormat    `json:"content_format"`
+	Rationale           string                    `json:"rationale,omitempty"`
+	Tag                 string                    `json:"tag,omitempty"`
+	Points              float64                   `json:"points"`
+	Choices             []*ExportedChoice         `json:"choices,omitempty"`
+	Pairs               []*ExportedPair           `json:"pairs,omitempty"`
+	AcceptedAnswers     []*ExportedAcceptedAnswer `json:"accepted_answers,omitempty"`
+	CaseSensitive       bool                      `json:"case_sensitive,omitempty"`
+	DiacriticsSensitive bool                      `json:"diacritics_sensitive,omitempty"`
+	NumericValue        *float64                  `json:"numeric_value,omitempty"`
+	Tolerance           float64                   `json:"tolerance,omitempty"`
+	ToleranceType       constant.ToleranceType    `json:"tolerance_type,omitempty"`
+	Units               []string                  `json:"units,omitempty"`
+}
+
+type ExportedChoice struct {
+	Content         string `json:"content"`
+	IsCorrectAnswer bool   `json:"is_correct_answer"`
+	CorrectPosition int    `json:"correct_position,omitempty"`
+	Explanation     string `json:"explanation,omitempty"`
+}
+
+type ExportedPair struct {
+	LeftContent  string `json:"left_content"`
+	RightContent string `json:"right_content"`
+}
+
+type ExportedAcceptedAnswer struct {
+	Content string `json:"content"`
+	IsRegex bool   `json:"is_regex"`
+}
+
+// QuestionsExport is the module written in a question file format,
+// Errors maps "questions[n]" to why the question at position n cannot be written
+type QuestionsExport struct {
+	Content string
+	Errors  map[string]string
+}
+
+// PackageExport is the published version written as a QTI content package. Skipped lists the
+// positions of the questions left out, Errors maps "questions[n]" to why.
+type PackageExport struct {
+	Content []byte
+	Skipped []int
+	Errors  map[string]string
+}
+
+// PackageImport reports a QTI package import, Skipped maps each item file left out to why
+type PackageImport struct {
+	Slug     string            `json:"slug"`
+	Imported int               `json:"imported"`
+	Skipped  map[string]string `json:"skipped"`
+}
@@ -1,22 +1,34 @@
 package response
 
 import (
+	"time"
+
 	"github.com/arvinpaundra/private-api/domain/module/constant"
 )
 
 type Module struct {
-	ID             string              `json:"id"`
-	UserID         string              `json:"user_id"`
-	SubjectID      string              `json:"subject_id"`
-	GradeID        string              `json:"grade_id"`
-	Title          string              `json:"title"`
-	Slug           string              `json:"slug"`
-	Description    *string             `json:"description"`
-	Type           constant.ModuleType `json:"type"`
-	IsPublished    bool                `json:"is_published"`
-	QuestionsCount int                 `json:"questions_count"`
-	Subject        *Subject            `json:"subject,omitempty"`
-	Grade          *Grade              `json:"grade,omitempty"`
+	ID                 string                 `json:"id"`
+	UserID             string                 `json:"user_id"`
+	SubjectID          string                 `json:"subject_id"`
+	GradeID            string                 `json:"grade_id"`
+	Title              string                 `json:"title"`
+	Slug               string                 `json:"slug"`
+	Description        *string                `json:"description"`
+	Type               constant.ModuleType    `json:"type"`
+	ScoringPolicy      constant.ScoringPolicy `json:"scoring_policy"`
+	WrongAnswerPenalty float64                `json:"wrong_answer_penalty"`
+	ShuffleQuestions   bool                   `json:"shuffle_questions"`
+	ShuffleChoices     bool                   `json:"shuffle_choices"`
+	DrawCount          int                    `json:"draw_count" validate:"min=0,max=500"`
+	StratifyDraw       bool                   `json:"stratify_draw"`
+	TimeLimitMinutes   int                    `json:"time_limit_minutes"`
+	OpensAt            *time.Time             `json:"opens_at"`
+	ClosesAt           *time.Time             `json:"closes_at"`
+	IsPublished        bool                   `json:"is_published"`
+	PublishedVersion   int                    `json:"published_version"`
+	QuestionsCount     int                    `json:"questions_count"`
+	Subject            *Subject               `json:"subject,omitempty"`
+	Grade              *Grade                 `json:"grade,omitempty"`
 }
 
 type Subject struct {
@@ -30,39 +42,147 @@ type Grade struct {
 }
 
 type ModuleDetail struct {
-	ID          string              `json:"id"`
-	Title       string              `json:"title"`
-	Slug        string              `json:"slug"`
-	Description *string             `json:"description"`
-	Type        constant.ModuleType `json:"type"`
-	IsPublished bool                `json:"is_published"`
-	Subject     *Subject            `json:"subject"`
-	Grade       *Grade              `json:"grade"`
-	Questions   []*Question         `json:"questions"`
+	ID                 string                 `json:"id"`
+	Title              string                 `json:"title"`
+	Slug               string                 `json:"slug"`
+	Description        *string                `json:"description"`
+	Type               constant.ModuleType    `json:"type"`
+	ScoringPolicy      constant.ScoringPolicy `json:"scoring_policy"`
+	WrongAnswerPenalty float64                `json:"wrong_answer_penalty"`
+	ShuffleQuestions   bool                   `json:"shuffle_questions"`
+	ShuffleChoices     bool                   `json:"shuffle_choices"`
+	DrawCount          int                    `json:"draw_count" validate:"min=0,max=500"`
+	StratifyDraw       bool                   `json:"stratify_draw"`
+	TimeLimitMinutes   int                    `json:"time_limit_minutes"`
+	OpensAt            *time.Time             `json:"opens_at"`
+	ClosesAt           *time.Time             `json:"closes_at"`
+	IsPublished        bool                   `json:"is_published"`
+	PublishedVersion   int                    `json:"published_version"`
+	Subject            *Subject               `json:"subject"`
+	Grade              *Grade                 `json:"grade"`
+	Questions          []*Question            `json:"questions"`
 }
 
 type Question struct {
-	ID      string              `json:"id"`
-	Content string              `json:"content"`
-	Slug    string              `json:"slug"`
-	Choices []*ChoiceWithAnswer `json:"choices"`
+	ID                  string                 `json:"id"`
+	Type                constant.QuestionType  `json:"type"`
+	Content             string                 `json:"content"`
+	ContentFormat       constant.ContentFormat `json:"content_format"`
+	Rationale           string                 `json:"rationale,omitempty"`
+	Tag                 string                 `json:"tag,omitempty"`
+	Slug                string                 `json:"slug"`
+	Position            int                    `json:"position"`
+	Points              float64                `json:"points"`
+	CaseSensitive       bool                   `json:"case_sensitive"`
+	DiacriticsSensitive bool                   `json:"diacritics_sensitive"`
+	Choices             []*ChoiceWithAnswer    `json:"choices"`
+	Pairs               []*PairWithAnswer      `json:"pairs,omitempty"`
+	AcceptedAnswers     []*AcceptedAnswer      `json:"accepted_answers,omitempty"`
+	NumericValue        *float64               `json:"numeric_value,omitempty"`
+	Tolerance           float64                `json:"tolerance,omitempty"`
+	ToleranceType       constant.ToleranceType `json:"tolerance_type,omitempty"`
+	Units               []string               `json:"units,omitempty"`
+	Attachments         []*Attachment          `json:"attachments"`
+	BankQuestionID      *string                `json:"bank_question_id,omitempty"`
 }
 
 type ChoiceWithAnswer struct {
-	ID              string `json:"id"`
-	Content         string `json:"content"`
-	IsCorrectAnswer bool   `json:"is_correct_answer"`
+	ID              string        `json:"id"`
+	Content         string        `json:"content"`
+	IsCorrectAnswer bool          `json:"is_correct_answer"`
+	CorrectPosition int           `json:"correct_position,omitempty"`
+	Explanation     string        `json:"explanation,omitempty"`
+	Attachments     []*Attachment `json:"attachments"`
 }
 
 type Choice struct {
+	ID          string        `json:"id"`
+	Content     string        `json:"content"`
+	Attachments []*Attachment `json:"attachments"`
+}
+
+// Attachment is an uploaded file with a signed link that expires after a short while
+type Attachment struct {
+	ID             string `json:"id"`
+	ChoicePosition int    `json:"choice_position,omitempty"`
+	Filename       string `json:"filename"`
+	ContentType    string `json:"content_type"`
+	Size           int64  `json:"size"`
+	URL            string `json:"url"`
+}
+
+type PairWithAnswer struct {
+	ID           string `json:"id"`
+	LeftContent  string `json:"left_content"`
+	RightID      string `json:"right_id"`
+	RightContent string `json:"right_content"`
+}
+
+type AcceptedAnswer struct {
+	ID      string `json:"id"`
+	Content string `json:"content"`
+	IsRegex bool   `json:"is_regex"`
+}
+
+// AnswerKey is everything needed to grade a question; only the part matching its type is set
+type AnswerKey struct {
+	Type               constant.QuestionType
+	Points             float64
+	WrongAnswerPenalty float64
+	Rationale          string
+	Choices            []*ChoiceWithAnswer
+	Pairs              []*PairWithAnswer
+	Text               *TextAnswerKey
+	Numeric            *NumericAnswerKey
+}
+
+// TextAnswerKey is what a short answer question accepts and how typed text is compared
+type TextAnswerKey struct {
+	CaseSensitive       bool
+	DiacriticsSensitive bool
+	AcceptedAnswers     []*AcceptedAnswer
+}
+
+type NumericAnswerKey struct {
+	Value         float64
+	Tolerance     float64
+	ToleranceType constant.ToleranceType
+	Units         []string
+}
+
+type MatchingItem struct {
 	ID      string `json:"id"`
 	Content string `json:"content"`
 }
 
 type QuestionDetail struct {
-	ID               string    `json:"id"`
-	Content          string    `json:"content"`
-	Slug             string    `json:"slug"`
-	Choices          []*Choice `json:"choices"`
-	NextQuestionSlug *string   `json:"next_question_slug"`
+	ID               string                 `json:"id"`
+	Type             constant.QuestionType  `json:"type"`
+	Content          string                 `json:"content"`
+	ContentFormat    constant.ContentFormat `json:"content_format"`
+	Slug             string                 `json:"slug"`
+	Points           float64                `json:"points"`
+	Choices          []*Choice              `json:"choices"`
+	LeftItems        []*MatchingItem        `json:"left_items,omitempty"`
+	RightItems       []*MatchingItem        `json:"right_items,omitempty"`
+	Units            []string               `json:"units,omitempty"`
+	Attachments      []*Attachment          `json:"attachments"`
+	NextQuestionSlug *string                `json:"next_question_slug"`
+	Version          int                    `json:"version"`
+	RemainingSeconds *int                   `json:"remaining_seconds"`
+}
+
+// QuestionDraw is what a new submission is served, QuestionIDs is nil when it is served every question
+type QuestionDraw struct {
+	QuestionIDs    []string
+	TotalQuestions int
+	TotalPoints    float64
+}
+
+// ImportQuestions reports the outcome of a file import, Errors maps "rows[n]" or "lines[n]" to why that question was rejected
+type ImportQuestions struct {
+	DryRun   bool              `json:"dry_run"`
+	Total    int               `json:"total"`
+	Imported int               `json:"imported"`
+	Errors   map[string]string `json:"-"`
 }
@@ -0,0 +1,99 @@
+package service
+
+import (
+	"context"
+
+	"github.com/arvinpaundra/private-api/domain/module/repository"
+	"github.com/arvinpaundra/private-api/domain/shared/interfaces"
+)
+
+type AddBankQuestionsCommand struct {
+	ModuleSlug      string   `json:"-" validate:"required"`
+	BankQuestionIDs []string `json:"bank_question_ids" validate:"required,min=1,max=50,unique,dive,required,uuid"`
+
+	// Link keeps the copies referencing the bank so later bank edits can be propagated
+	Link bool `json:"link"`
+}
+
+type AddBankQuestions struct {
+	authStorage  interfaces.AuthenticatedUser
+	moduleReader repository.ModuleReader
+	bankACL      repository.BankACL
+	uow          repository.UnitOfWork
+}
+
+func NewAddBankQuestions(
+	authStorage interfaces.AuthenticatedUser,
+	moduleReader repository.ModuleReader,
+	bankACL repository.BankACL,
+	uow repository.UnitOfWork,
+) *AddBankQuestions {
+	return &AddBankQuestions{
+		authStorage:  authStorage,
+		moduleReader: moduleReader,
+		bankACL:      bankACL,
+		uow:          uow,
+	}
+}
+
+func (s *AddBankQuestions) Execute(ctx context.Context, command *AddBankQuestionsCommand) error {
+	// Load module with its questions, scoped to the user
+	module, err := s.moduleReader.FindModuleDetailBySlug(ctx, command.ModuleSlug, s.authStorage.GetUserId())
+	if err != nil {
+		return err
+	}
+
+	// New questions are appended after the existing ones
+	nextPosition := module.NextQuestionPosition()
+
+	for _, bankQuestionID := range command.BankQuestionIDs {
+		// Get bank question via ACL
+		bankQuestion, err := s.bankACL.GetBankQuestion(ctx, bankQuestionID, s.authStorage.GetUserId())
+		if err != nil {
+			return err
+		}
+
+		// Copy the bank question into the module
+		question, err := bankQuestion.Clone(module.ID)
+		if err != nil {
+			return err
+		}
+
+		question.UpdatePosition(nextPosition)
+		nextPosition++
+
+		if command.Link {
+			question.LinkBankQuestion(bankQuestionID)
+		}
+
+		// Validate answers against the module type
+		err = module.ValidateQuestion(question)
+		if err != nil {
+			return err
+		}
+
+		module.AddQuestion(question)
+	}
+
+	// Begin transaction
+	tx, err := s.uow.Begin()
+	if err != nil {
+		return err
+	}
+
+	// Save module (which will cascade to questions)
+	err = tx.ModuleWriter().Save(ctx, module)
+	if err != nil {
+		if uowErr := tx.Rollback(); uowErr != nil {
+			return uowErr
+		}
+		return err
+	}
+
+	// Commit transaction
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	return nil
+}
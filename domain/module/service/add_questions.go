@@ -15,14 +15,47 @@ type AddQuestionsCommand struct {
 }
 
 type AddQuestion struct {
-	ID      *string              `json:"id,omitempty"`
-	Content string               `json:"content" validate:"required"`
-	Choices []*AddQuestionChoice `json:"choices" validate:"required,min=2,max=4,dive"`
+	ID      *string               `json:"id,omitempty"`
+	Type    constant.QuestionType `json:"type" validate:"omitempty,oneof=single_choice multiple_select matching short_answer numeric ordering"`
+	Content string                `json:"content" validate:"required,max=20000"`
+	Points  float64               `json:"points" validate:"min=0,max=100"`
+	Choices []*AddQuestionChoice  `json:"choices" validate:"omitempty,min=2,max=10,dive"`
+	Pairs   []*AddQuestionPair    `json:"pairs" validate:"omitempty,min=2,max=10,dive"`
+
+	// ContentFormat applies to the question and its choices, plain text when empty
+	ContentFormat constant.ContentFormat `json:"content_format" validate:"omitempty,oneof=plain markdown"`
+
+	// Rationale is shown to students once they have answered
+	Rationale string `json:"rationale" validate:"max=5000"`
+
+	// Tag groups the question for a stratified draw, such as a topic or a difficulty
+	Tag string `json:"tag" validate:"max=50"`
+
+	AcceptedAnswers     []*AddQuestionAcceptedAnswer `json:"accepted_answers" validate:"omitempty,min=1,max=10,dive"`
+	CaseSensitive       bool                         `json:"case_sensitive"`
+	DiacriticsSensitive bool                         `json:"diacritics_sensitive"`
+
+	NumericValue  *float64               `json:"numeric_value,omitempty"`
+	Tolerance     float64                `json:"tolerance" validate:"min=0"`
+	ToleranceType constant.ToleranceType `json:"tolerance_type" validate:"omitempty,oneof=absolute percentage"`
+	Units         []string               `json:"units" validate:"omitempty,max=10,dive,required,max=50"`
 }
 
 type AddQuestionChoice struct {
-	Content         string `json:"content" validate:"required"`
+	Content         string `json:"content" validate:"required,max=5000"`
 	IsCorrectAnswer bool   `json:"is_correct_answer"`
+	CorrectPosition int    `json:"correct_position" validate:"min=0"`
+	Explanation     string `json:"explanation" validate:"max=2000"`
+}
+
+type AddQuestionPair struct {
+	LeftContent  string `json:"left_content" validate:"required,max=2000"`
+	RightContent string `json:"right_content" validate:"required,max=2000"`
+}
+
+type AddQuestionAcceptedAnswer struct {
+	Content string `json:"content" validate:"required,max=255"`
+	IsRegex bool   `json:"is_regex"`
 }
 
 type AddQuestions struct {
@@ -62,8 +95,17 @@ func (s *AddQuestions) Execute(ctx context.Context, command *AddQuestionsCommand
 		existingQuestions[q.ID] = q
 	}
 
+	// New questions are appended after the existing ones
+	nextPosition := existingModule.NextQuestionPosition()
+
 	// Process each question
 	for _, questionCmd := range command.Questions {
+		// Fall back to the module's default question type
+		questionType := questionCmd.Type
+		if questionType == "" {
+			questionType = module.DefaultQuestionType()
+		}
+
 		if questionCmd.ID != nil && *questionCmd.ID != "" {
 			// Update existing question
 			existingQuestion, exists := existingQuestions[*questionCmd.ID]
@@ -71,22 +113,12 @@ func (s *AddQuestions) Execute(ctx context.Context, command *AddQuestionsCommand
 				return constant.ErrQuestionNotFound
 			}
 
-			// Update question content
-			existingQuestion.UpdateContent(questionCmd.Content)
-
-			// Clear existing choices and add new ones
-			existingQuestion.ClearChoices()
-
-			for _, choiceCmd := range questionCmd.Choices {
-				choice := entity.NewQuestionChoice(existingQuestion.ID, choiceCmd.Content)
-				if choiceCmd.IsCorrectAnswer {
-					choice.SetAsCorrectAnswer()
-				}
-				existingQuestion.AddChoice(choice)
-			}
+			// Replace question content, type and answers
+			replaceQuestion(existingQuestion, questionCmd, questionType)
+			existingQuestion.UpdateTag(questionCmd.Tag)
 
-			// Validate choices
-			err = existingQuestion.IsValidChoices()
+			// Validate answers against the module type
+			err = module.ValidateQuestion(existingQuestion)
 			if err != nil {
 				return err
 			}
@@ -95,23 +127,20 @@ func (s *AddQuestions) Execute(ctx context.Context, command *AddQuestionsCommand
 			module.AddQuestion(existingQuestion)
 		} else {
 			// Create new question
-			question, err := entity.NewQuestion(module.ID, questionCmd.Content)
+			question, err := entity.NewQuestion(module.ID, questionCmd.Content, questionCmd.ContentFormat, questionType)
 			if err != nil {
 				return err
 			}
 
-			// Add choices to question
-			for _, choiceCmd := range questionCmd.Choices {
-				choice := entity.NewQuestionChoice(question.ID, choiceCmd.Content)
-				if choiceCmd.IsCorrectAnswer {
-					choice.SetAsCorrectAnswer()
-				}
+			question.UpdatePosition(nextPosition)
+			nextPosition++
 
-				question.AddChoice(choice)
-			}
+			// Add answers to question
+			addAnswers(question, questionCmd)
+			question.UpdateTag(questionCmd.Tag)
 
-			// Validate choices
-			err = question.IsValidChoices()
+			// Validate answers against the module type
+			err = module.ValidateQuestion(question)
 			if err != nil {
 				return err
 			}
@@ -143,3 +172,68 @@ func (s *AddQuestions) Execute(ctx context.Context, command *AddQuestionsCommand
 
 	return nil
 }
+
+// replaceQuestion overwrites the content, type and answers of an existing question
+func replaceQuestion(question *entity.Question, questionCmd *AddQuestion, questionType constant.QuestionType) {
+	question.UpdateContent(questionCmd.Content, questionCmd.ContentFormat)
+	question.UpdateType(questionType)
+
+	// Clear existing answers and add new ones
+	question.ClearChoices()
+	question.ClearPairs()
+	question.ClearAcceptedAnswers()
+	question.ClearUnits()
+
+	addAnswers(question, questionCmd)
+}
+
+// addAnswers attaches the answers and points described by the command to the question
+func addAnswers(question *entity.Question, questionCmd *AddQuestion) {
+	// a question is worth one point unless told otherwise
+	points := questionCmd.Points
+	if points == 0 {
+		points = 1
+	}
+
+	question.UpdatePoints(points)
+	question.UpdateRationale(questionCmd.Rationale)
+
+	for i, choiceCmd := range questionCmd.Choices {
+		choice := entity.NewQuestionChoice(question.ID, question.SanitizeContent(choiceCmd.Content), i+1)
+		if choiceCmd.IsCorrectAnswer {
+			choice.SetAsCorrectAnswer()
+		}
+
+		if question.IsOrdering() {
+			choice.SetCorrectPosition(choiceCmd.CorrectPosition)
+		}
+
+		choice.SetExplanation(question.SanitizeContent(choiceCmd.Explanation))
+
+		question.AddChoice(choice)
+	}
+
+	for _, pairCmd := range questionCmd.Pairs {
+		question.AddPair(entity.NewQuestionPair(
+			question.ID, question.SanitizeContent(pairCmd.LeftContent), question.SanitizeContent(pairCmd.RightContent),
+		))
+	}
+
+	for _, answerCmd := range questionCmd.AcceptedAnswers {
+		question.AddAcceptedAnswer(entity.NewQuestionAcceptedAnswer(question.ID, answerCmd.Content, answerCmd.IsRegex))
+	}
+
+	question.UpdateMatchRules(questionCmd.CaseSensitive, questionCmd.DiacriticsSensitive)
+
+	for _, unit := range questionCmd.Units {
+		question.AddUnit(entity.NewQuestionUnit(question.ID, unit))
+	}
+
+	// default to an absolute tolerance when none is given
+	toleranceType := questionCmd.ToleranceType
+	if toleranceType == "" {
+		toleranceType = constant.AbsoluteTolerance
+	}
+
+	question.UpdateNumericAnswer(questionCmd.NumericValue, questionCmd.Tolerance, toleranceType)
+}
@@ -0,0 +1,110 @@
+package service
+
+import (
+	"context"
+
+	"github.com/arvinpaundra/private-api/domain/module/constant"
+	"github.com/arvinpaundra/private-api/domain/module/repository"
+	"github.com/arvinpaundra/private-api/domain/shared/interfaces"
+)
+
+type CloneModuleCommand struct {
+	Slug      string  `json:"-" validate:"required"`
+	Title     *string `json:"title,omitempty" validate:"omitempty,max=100"`
+	SubjectID *string `json:"subject_id,omitempty"`
+	GradeID   *string `json:"grade_id,omitempty"`
+}
+
+type CloneModule struct {
+	authStorage  interfaces.AuthenticatedUser
+	moduleReader repository.ModuleReader
+	uow          repository.UnitOfWork
+	subjectACL   repository.SubjectACL
+	gradeACL     repository.GradeACL
+}
+
+func NewCloneModule(
+	authStorage interfaces.AuthenticatedUser,
+	moduleReader repository.ModuleReader,
+	uow repository.UnitOfWork,
+	subjectACL repository.SubjectACL,
+	gradeACL repository.GradeACL,
+) *CloneModule {
+	return &CloneModule{
+		authStorage:  authStorage,
+		moduleReader: moduleReader,
+		uow:          uow,
+		subjectACL:   subjectACL,
+		gradeACL:     gradeACL,
+	}
+}
+
+func (s *CloneModule) Execute(ctx context.Context, command *CloneModuleCommand) (string, error) {
+	// Load module with its questions, scoped to the user
+	source, err := s.moduleReader.FindModuleDetailBySlug(ctx, command.Slug, s.authStorage.GetUserId())
+	if err != nil {
+		return "", err
+	}
+
+	// keep the source details unless overridden
+	title := source.Title
+	if command.Title != nil && *command.Title != "" {
+		title = *command.Title
+	}
+
+	subjectID := source.SubjectID
+	if command.SubjectID != nil && *command.SubjectID != subjectID {
+		isSubjectExist, err := s.subjectACL.IsSubjectExist(ctx, *command.SubjectID, s.authStorage.GetUserId())
+		if err != nil {
+			return "", err
+		}
+
+		if !isSubjectExist {
+			return "", constant.ErrSubjectNotFound
+		}
+
+		subjectID = *command.SubjectID
+	}
+
+	gradeID := source.GradeID
+	if command.GradeID != nil && *command.GradeID != gradeID {
+		isGradeExist, err := s.gradeACL.IsGradeExist(ctx, *command.GradeID, s.authStorage.GetUserId())
+		if err != nil {
+			return "", err
+		}
+
+		if !isGradeExist {
+			return "", constant.ErrGradeNotFound
+		}
+
+		gradeID = *command.GradeID
+	}
+
+	// Deep-copy the module, the clone starts unpublished
+	module, err := source.Clone(s.authStorage.GetUserId(), subjectID, gradeID, title)
+	if err != nil {
+		return "", err
+	}
+
+	// Begin transaction
+	tx, err := s.uow.Begin()
+	if err != nil {
+		return "", err
+	}
+
+	// Save module (which will cascade to questions)
+	err = tx.ModuleWriter().Save(ctx, module)
+	if err != nil {
+		if uowErr := tx.Rollback(); uowErr != nil {
+			return "", uowErr
+		}
+		return "", err
+	}
+
+	// Commit transaction
+	if err := tx.Commit(); err != nil {
+		return "", err
+	}
+
+	return module.Slug, nil
+}
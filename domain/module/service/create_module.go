@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"github.com/arvinpaundra/private-api/domain/module/constant"
 	"github.com/arvinpaundra/private-api/domain/module/entity"
@@ -10,10 +11,34 @@ import (
 )
 
 type CreateModuleCommand struct {
-	Title       string  `json:"title" validate:"required,max=100"`
-	SubjectID   string  `json:"subject_id" validate:"required"`
-	GradeID     string  `json:"grade_id" validate:"required"`
-	Description *string `json:"description,omitempty"`
+	Title         string                 `json:"title" validate:"required,max=100"`
+	SubjectID     string                 `json:"subject_id" validate:"required"`
+	GradeID       string                 `json:"grade_id" validate:"required"`
+	Description   *string                `json:"description,omitempty"`
+	Type          constant.ModuleType    `json:"type" validate:"omitempty,oneof=multiple_choice matching_type"`
+	ScoringPolicy constant.ScoringPolicy `json:"scoring_policy" validate:"omitempty,oneof=all_or_nothing proportional right_minus_wrong"`
+
+	// WrongAnswerPenalty is the fraction of a question's points taken off for a completely wrong answer
+	WrongAnswerPenalty float64 `json:"wrong_answer_penalty" validate:"min=0,max=1"`
+
+	// ShuffleQuestions serves every submission the questions in its own order
+	ShuffleQuestions bool `json:"shuffle_questions"`
+
+	// ShuffleChoices serves every submission the choices of each question in its own order
+	ShuffleChoices bool `json:"shuffle_choices"`
+
+	// DrawCount is how many questions every submission draws at random, 0 serves them all
+	DrawCount int `json:"draw_count" validate:"min=0,max=500"`
+
+	// StratifyDraw draws from every question tag in proportion to its share of the questions
+	StratifyDraw bool `json:"stratify_draw"`
+
+	// TimeLimitMinutes is how long every submission runs before it expires, 0 leaves it untimed
+	TimeLimitMinutes int `json:"time_limit_minutes" validate:"min=0,max=1440"`
+
+	// OpensAt and ClosesAt bound when students can take the published module, both optional
+	OpensAt  *time.Time `json:"opens_at"`
+	ClosesAt *time.Time `json:"closes_at"`
 }
 
 type CreateModule struct {
@@ -58,8 +83,32 @@ func (s *CreateModule) Execute(ctx context.Context, command *CreateModuleCommand
 		return "", constant.ErrGradeNotFound
 	}
 
+	// default to multiple choice when no type is given
+	moduleType := command.Type
+	if moduleType == "" {
+		moduleType = constant.MultipleChoice
+	}
+
+	// default to all or nothing when no scoring policy is given
+	scoringPolicy := command.ScoringPolicy
+	if scoringPolicy == "" {
+		scoringPolicy = constant.AllOrNothing
+	}
+
 	// create module
-	module, err := entity.NewModule(s.authStorage.GetUserId(), command.SubjectID, command.GradeID, command.Title, command.Description)
+	module, err := entity.NewModule(s.authStorage.GetUserId(), command.SubjectID, command.GradeID, command.Title, command.Description, moduleType, scoringPolicy, entity.ModuleSettings{
+		WrongAnswerPenalty: command.WrongAnswerPenalty,
+		ShuffleQuestions:   command.ShuffleQuestions,
+		ShuffleChoices:     command.ShuffleChoices,
+		DrawCount:          command.DrawCount,
+		StratifyDraw:       command.StratifyDraw,
+		TimeLimitMinutes:   command.TimeLimitMinutes,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	err = module.Schedule(command.OpensAt, command.ClosesAt)
 	if err != nil {
 		return "", err
 	}
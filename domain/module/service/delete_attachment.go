@@ -0,0 +1,75 @@
+package service
+
+import (
+	"context"
+
+	"github.com/arvinpaundra/private-api/domain/module/repository"
+	"github.com/arvinpaundra/private-api/domain/shared/interfaces"
+)
+
+type DeleteAttachmentCommand struct {
+	ModuleSlug   string `validate:"required"`
+	QuestionID   string `validate:"required,uuid"`
+	AttachmentID string `validate:"required,uuid"`
+}
+
+type DeleteAttachment struct {
+	authStorage  interfaces.AuthenticatedUser
+	moduleReader repository.ModuleReader
+	uow          repository.UnitOfWork
+}
+
+func NewDeleteAttachment(
+	authStorage interfaces.AuthenticatedUser,
+	moduleReader repository.ModuleReader,
+	uow repository.UnitOfWork,
+) *DeleteAttachment {
+	return &DeleteAttachment{
+		authStorage:  authStorage,
+		moduleReader: moduleReader,
+		uow:          uow,
+	}
+}
+
+func (s *DeleteAttachment) Execute(ctx context.Context, command *DeleteAttachmentCommand) error {
+	// Load module with its questions, scoped to the user
+	module, err := s.moduleReader.FindModuleDetailBySlug(ctx, command.ModuleSlug, s.authStorage.GetUserId())
+	if err != nil {
+		return err
+	}
+
+	question, err := module.FindQuestion(command.QuestionID)
+	if err != nil {
+		return err
+	}
+
+	// The stored file is kept, published versions may still show it
+	err = question.RemoveAttachment(command.AttachmentID)
+	if err != nil {
+		return err
+	}
+
+	module.MarkUpdate()
+
+	// Begin transaction
+	tx, err := s.uow.Begin()
+	if err != nil {
+		return err
+	}
+
+	// Save module (which will cascade to questions)
+	err = tx.ModuleWriter().Save(ctx, module)
+	if err != nil {
+		if uowErr := tx.Rollback(); uowErr != nil {
+			return uowErr
+		}
+		return err
+	}
+
+	// Commit transaction
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	return nil
+}
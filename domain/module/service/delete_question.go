@@ -0,0 +1,66 @@
+package service
+
+import (
+	"context"
+
+	"github.com/arvinpaundra/private-api/domain/module/repository"
+	"github.com/arvinpaundra/private-api/domain/shared/interfaces"
+)
+
+type DeleteQuestionCommand struct {
+	ModuleSlug string `validate:"required"`
+	QuestionID string `validate:"required,uuid"`
+}
+
+type DeleteQuestion struct {
+	authStorage  interfaces.AuthenticatedUser
+	moduleReader repository.ModuleReader
+	uow          repository.UnitOfWork
+}
+
+func NewDeleteQuestion(
+	authStorage interfaces.AuthenticatedUser,
+	moduleReader repository.ModuleReader,
+	uow repository.UnitOfWork,
+) *DeleteQuestion {
+	return &DeleteQuestion{
+		authStorage:  authStorage,
+		moduleReader: moduleReader,
+		uow:          uow,
+	}
+}
+
+func (s *DeleteQuestion) Execute(ctx context.Context, command *DeleteQuestionCommand) error {
+	// Load module with its questions, scoped to the user
+	module, err := s.moduleReader.FindModuleDetailBySlug(ctx, command.ModuleSlug, s.authStorage.GetUserId())
+	if err != nil {
+		return err
+	}
+
+	err = module.RemoveQuestion(command.QuestionID)
+	if err != nil {
+		return err
+	}
+
+	// Begin transaction
+	tx, err := s.uow.Begin()
+	if err != nil {
+		return err
+	}
+
+	// Save module (which will cascade to questions)
+	err = tx.ModuleWriter().Save(ctx, module)
+	if err != nil {
+		if uowErr := tx.Rollback(); uowErr != nil {
+			return uowErr
+		}
+		return err
+	}
+
+	// Commit transaction
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package service
+
+import (
+	"context"
+
+	"github.com/arvinpaundra/private-api/core/util"
+	"github.com/arvinpaundra/private-api/domain/module/repository"
+	"github.com/arvinpaundra/private-api/domain/module/response"
+)
+
+type DrawQuestionsCommand struct {
+	ModuleSlug string `validate:"required"`
+	Version    int    `validate:"min=0"`
+}
+
+type DrawQuestions struct {
+	moduleReader repository.ModuleReader
+}
+
+func NewDrawQuestions(
+	moduleReader repository.ModuleReader,
+) *DrawQuestions {
+	return &DrawQuestions{
+		moduleReader: moduleReader,
+	}
+}
+
+// Execute picks the questions a new submission on the version is served
+func (s *DrawQuestions) Execute(ctx context.Context, command *DrawQuestionsCommand) (*response.QuestionDraw, error) {
+	// Load the published version, the one currently served unless one is given
+	version, err := s.moduleReader.FindPublishedVersion(ctx, command.ModuleSlug, command.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	// Every submission draws at random, the questions it drew are stored rather than the seed
+	seed, err := util.RandomSeed()
+	if err != nil {
+		return nil, err
+	}
+
+	questions := version.Draw(seed)
+
+	result := &response.QuestionDraw{
+		TotalQuestions: len(questions),
+	}
+
+	for _, question := range questions {
+		result.TotalPoints += question.Points
+	}
+
+	// Only a partial draw is worth remembering, otherwise the submission is served everything
+	if version.DrawsQuestions() {
+		result.QuestionIDs = make([]string, len(questions))
+
+		for i, question := range questions {
+			result.QuestionIDs[i] = question.ID
+		}
+	}
+
+	return result, nil
+}
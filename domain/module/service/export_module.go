@@ -0,0 +1,136 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/arvinpaundra/private-api/domain/module/constant"
+	"github.com/arvinpaundra/private-api/domain/module/entity"
+	"github.com/arvinpaundra/private-api/domain/module/repository"
+	"github.com/arvinpaundra/private-api/domain/module/response"
+	"github.com/arvinpaundra/private-api/domain/shared/interfaces"
+)
+
+type ExportModuleCommand struct {
+	Slug string `validate:"required"`
+}
+
+type ExportModule struct {
+	authStorage  interfaces.AuthenticatedUser
+	moduleReader repository.ModuleReader
+	subjectACL   repository.SubjectACL
+	gradeACL     repository.GradeACL
+}
+
+func NewExportModule(
+	authStorage interfaces.AuthenticatedUser,
+	moduleReader repository.ModuleReader,
+	subjectACL repository.SubjectACL,
+	gradeACL repository.GradeACL,
+) *ExportModule {
+	return &ExportModule{
+		authStorage:  authStorage,
+		moduleReader: moduleReader,
+		subjectACL:   subjectACL,
+		gradeACL:     gradeACL,
+	}
+}
+
+// Execute exports the draft questions of the module. Attachments are left out,
+// their files live in this environment's storage.
+func (s *ExportModule) Execute(ctx context.Context, command *ExportModuleCommand) (*response.ModuleExport, error) {
+	// Load module with its questions, scoped to the user
+	module, err := s.moduleReader.FindModuleDetailBySlug(ctx, command.Slug, s.authStorage.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	// Get subject name via ACL
+	subjectName, err := s.subjectACL.GetSubjectName(ctx, module.SubjectID, s.authStorage.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	// Get grade name via ACL
+	gradeName, err := s.gradeACL.GetGradeName(ctx, module.GradeID, s.authStorage.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	questions := make([]*response.ExportedQuestion, len(module.Questions))
+
+	for i, question := range module.Questions {
+		questions[i] = toExportedQuestion(question)
+	}
+
+	result := &response.ModuleExport{
+		SchemaVersion: constant.ExportSchemaVersion,
+		ExportedAt:    time.Now().UTC(),
+		Module: &response.ExportedModule{
+			Title:              module.Title,
+			Description:        module.Description,
+			Subject:            subjectName,
+			Grade:              gradeName,
+			Type:               module.Type,
+			ScoringPolicy:      module.ScoringPolicy,
+			WrongAnswerPenalty: module.WrongAnswerPenalty,
+			ShuffleQuestions:   module.ShuffleQuestions,
+			ShuffleChoices:     module.ShuffleChoices,
+			DrawCount:          module.DrawCount,
+			StratifyDraw:       module.StratifyDraw,
+			TimeLimitMinutes:   module.TimeLimitMinutes,
+			Questions:          questions,
+		},
+	}
+
+	return result, nil
+}
+
+func toExportedQuestion(question *entity.Question) *response.ExportedQuestion {
+	exported := &response.ExportedQuestion{
+		Type:                question.Type,
+		Content:             question.Content,
+		ContentFormat:       question.ContentFormat,
+		Rationale:           question.Rationale,
+		Tag:                 question.Tag,
+		Points:              question.Points,
+		CaseSensitive:       question.CaseSensitive,
+		DiacriticsSensitive: question.DiacriticsSensitive,
+	}
+
+	for _, choice := range question.Choices {
+		exported.Choices = append(exported.Choices, &response.ExportedChoice{
+			Content:         choice.Content,
+			IsCorrectAnswer: choice.IsCorrectAnswer,
+			CorrectPosition: choice.CorrectPosition,
+			Explanation:     choice.Explanation,
+		})
+	}
+
+	for _, pair := range question.Pairs {
+		exported.Pairs = append(exported.Pairs, &response.ExportedPair{
+			LeftContent:  pair.LeftContent,
+			RightContent: pair.RightContent,
+		})
+	}
+
+	for _, answer := range question.AcceptedAnswers {
+		exported.AcceptedAnswers = append(exported.AcceptedAnswers, &response.ExportedAcceptedAnswer{
+			Content: answer.Content,
+			IsRegex: answer.IsRegex,
+		})
+	}
+
+	// Only numeric questions carry an expected value
+	if question.Type == constant.Numeric {
+		exported.NumericValue = question.NumericValue
+		exported.Tolerance = question.Tolerance
+		exported.ToleranceType = question.ToleranceType
+
+		for _, unit := range question.Units {
+			exported.Units = append(exported.Units, unit.Name)
+		}
+	}
+
+	return exported
+}
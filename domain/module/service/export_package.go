@@ -0,0 +1,78 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/arvinpaundra/private-api/core/qti"
+	"github.com/arvinpaundra/private-api/domain/module/constant"
+	"github.com/arvinpaundra/private-api/domain/module/repository"
+	"github.com/arvinpaundra/private-api/domain/module/response"
+	"github.com/arvinpaundra/private-api/domain/shared/interfaces"
+)
+
+type ExportPackageCommand struct {
+	Slug string `validate:"required"`
+}
+
+type ExportPackage struct {
+	authStorage  interfaces.AuthenticatedUser
+	moduleReader repository.ModuleReader
+}
+
+func NewExportPackage(
+	authStorage interfaces.AuthenticatedUser,
+	moduleReader repository.ModuleReader,
+) *ExportPackage {
+	return &ExportPackage{
+		authStorage:  authStorage,
+		moduleReader: moduleReader,
+	}
+}
+
+// Execute writes the version students are served as a QTI 2.1 content package. Only choice
+// questions are written, the others are skipped and reported rather than failing the export.
+func (s *ExportPackage) Execute(ctx context.Context, command *ExportPackageCommand) (*response.PackageExport, error) {
+	// Check the module belongs to the user
+	module, err := s.moduleReader.FindBySlug(ctx, command.Slug, s.authStorage.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	if !module.IsPublished {
+		return nil, constant.ErrModuleNotPublished
+	}
+
+	version, err := s.moduleReader.FindPublishedVersion(ctx, command.Slug, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &response.PackageExport{
+		Errors: make(map[string]string),
+	}
+
+	items := make([]*qti.Item, 0, len(version.Questions))
+
+	for _, question := range version.Questions {
+		item, err := toQTIItem(question)
+		if err != nil {
+			result.Skipped = append(result.Skipped, question.Position)
+			result.Errors[fmt.Sprintf("questions[%d]", question.Position)] = err.Error()
+			continue
+		}
+
+		items = append(items, item)
+	}
+
+	if len(items) == 0 {
+		return result, constant.ErrNoExportableQuestions
+	}
+
+	result.Content, err = qti.WritePackage(fmt.Sprintf("module-%s-v%d", module.Slug, version.Version), items)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,70 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/arvinpaundra/private-api/core/moodle"
+	"github.com/arvinpaundra/private-api/domain/module/constant"
+	"github.com/arvinpaundra/private-api/domain/module/repository"
+	"github.com/arvinpaundra/private-api/domain/module/response"
+	"github.com/arvinpaundra/private-api/domain/shared/interfaces"
+)
+
+type ExportQuestionsCommand struct {
+	Slug   string              `validate:"required"`
+	Format constant.FileFormat `json:"format" validate:"required,oneof=gift aiken"`
+}
+
+type ExportQuestions struct {
+	authStorage  interfaces.AuthenticatedUser
+	moduleReader repository.ModuleReader
+}
+
+func NewExportQuestions(
+	authStorage interfaces.AuthenticatedUser,
+	moduleReader repository.ModuleReader,
+) *ExportQuestions {
+	return &ExportQuestions{
+		authStorage:  authStorage,
+		moduleReader: moduleReader,
+	}
+}
+
+// Execute writes the draft questions as a GIFT or Aiken file. When a question cannot be
+// written in the format nothing is exported and every such question is reported.
+func (s *ExportQuestions) Execute(ctx context.Context, command *ExportQuestionsCommand) (*response.QuestionsExport, error) {
+	// Load module with its questions, scoped to the user
+	module, err := s.moduleReader.FindModuleDetailBySlug(ctx, command.Slug, s.authStorage.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	result := &response.QuestionsExport{
+		Errors: make(map[string]string),
+	}
+
+	questions := make([]*moodle.Question, 0, len(module.Questions))
+
+	for _, question := range module.Questions {
+		exported, err := toMoodleQuestion(question, command.Format)
+		if err != nil {
+			result.Errors[fmt.Sprintf("questions[%d]", question.Position)] = err.Error()
+			continue
+		}
+
+		questions = append(questions, exported)
+	}
+
+	if len(result.Errors) > 0 {
+		return result, constant.ErrUnexportableQuestions
+	}
+
+	if command.Format == constant.AikenFile {
+		result.Content = moodle.WriteAiken(questions)
+	} else {
+		result.Content = moodle.WriteGIFT(questions)
+	}
+
+	return result, nil
+}
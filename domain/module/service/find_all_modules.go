@@ -104,16 +104,25 @@ func (s *FindAllModules) Execute(ctx context.Context, command *FindAllModulesCom
 
 	for i, module := range modules {
 		results[i] = &response.Module{
-			ID:             module.ID,
-			UserID:         module.UserID,
-			SubjectID:      module.SubjectID,
-			GradeID:        module.GradeID,
-			Title:          module.Title,
-			Slug:           module.Slug,
-			Description:    module.Description,
-			Type:           module.Type,
-			IsPublished:    module.IsPublished,
-			QuestionsCount: len(module.Questions),
+			ID:                 module.ID,
+			UserID:             module.UserID,
+			SubjectID:          module.SubjectID,
+			GradeID:            module.GradeID,
+			Title:              module.Title,
+			Slug:               module.Slug,
+			Description:        module.Description,
+			Type:               module.Type,
+			ScoringPolicy:      module.ScoringPolicy,
+			WrongAnswerPenalty: module.WrongAnswerPenalty,
+			ShuffleQuestions:   module.ShuffleQuestions,
+			ShuffleChoices:     module.ShuffleChoices,
+			DrawCount:          module.DrawCount,
+			StratifyDraw:       module.StratifyDraw,
+			TimeLimitMinutes:   module.TimeLimitMinutes,
+			OpensAt:            module.OpensAt,
+			ClosesAt:           module.ClosesAt,
+			IsPublished:        module.IsPublished,
+			QuestionsCount:     len(module.Questions),
 			Subject: &response.Subject{
 				ID:   module.SubjectID,
 				Name: subjectNames[module.SubjectID],
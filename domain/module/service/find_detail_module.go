@@ -39,15 +39,24 @@ func (s *FindDetailModule) Execute(ctx context.Context, command *FindDetailModul
 	}
 
 	result := &response.Module{
-		ID:             module.ID,
-		UserID:         module.UserID,
-		SubjectID:      module.SubjectID,
-		GradeID:        module.GradeID,
-		Slug:           module.Slug,
-		Title:          module.Title,
-		Type:           module.Type,
-		IsPublished:    module.IsPublished,
-		QuestionsCount: totalQuestions,
+		ID:                 module.ID,
+		UserID:             module.UserID,
+		SubjectID:          module.SubjectID,
+		GradeID:            module.GradeID,
+		Slug:               module.Slug,
+		Title:              module.Title,
+		Type:               module.Type,
+		ScoringPolicy:      module.ScoringPolicy,
+		WrongAnswerPenalty: module.WrongAnswerPenalty,
+		ShuffleQuestions:   module.ShuffleQuestions,
+		ShuffleChoices:     module.ShuffleChoices,
+		DrawCount:          module.DrawCount,
+		StratifyDraw:       module.StratifyDraw,
+		TimeLimitMinutes:   module.TimeLimitMinutes,
+		OpensAt:            module.OpensAt,
+		ClosesAt:           module.ClosesAt,
+		IsPublished:        module.IsPublished,
+		QuestionsCount:     totalQuestions,
 	}
 
 	return result, nil
@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 
+	"github.com/arvinpaundra/private-api/core/storage"
 	"github.com/arvinpaundra/private-api/domain/module/repository"
 	"github.com/arvinpaundra/private-api/domain/module/response"
 	"github.com/arvinpaundra/private-api/domain/shared/interfaces"
@@ -17,6 +18,7 @@ type FindDetailModuleQuestions struct {
 	moduleReader repository.ModuleReader
 	subjectACL   repository.SubjectACL
 	gradeACL     repository.GradeACL
+	fileStorage  storage.Storage
 }
 
 func NewFindDetailModuleQuestions(
@@ -24,12 +26,14 @@ func NewFindDetailModuleQuestions(
 	moduleReader repository.ModuleReader,
 	subjectACL repository.SubjectACL,
 	gradeACL repository.GradeACL,
+	fileStorage storage.Storage,
 ) *FindDetailModuleQuestions {
 	return &FindDetailModuleQuestions{
 		authStorage:  authStorage,
 		moduleReader: moduleReader,
 		subjectACL:   subjectACL,
 		gradeACL:     gradeACL,
+		fileStorage:  fileStorage,
 	}
 }
 
@@ -58,28 +62,94 @@ func (s *FindDetailModuleQuestions) Execute(ctx context.Context, command *FindDe
 		choices := make([]*response.ChoiceWithAnswer, len(question.Choices))
 
 		for j, choice := range question.Choices {
+			attachments, err := toAttachmentResponses(ctx, s.fileStorage, question.AttachmentsOf(choice.Position))
+			if err != nil {
+				return nil, err
+			}
+
 			choices[j] = &response.ChoiceWithAnswer{
 				ID:              choice.ID,
 				Content:         choice.Content,
 				IsCorrectAnswer: choice.IsCorrectAnswer,
+				CorrectPosition: choice.CorrectPosition,
+				Explanation:     choice.Explanation,
+				Attachments:     attachments,
+			}
+		}
+
+		pairs := make([]*response.PairWithAnswer, len(question.Pairs))
+
+		for j, pair := range question.Pairs {
+			pairs[j] = &response.PairWithAnswer{
+				ID:           pair.ID,
+				LeftContent:  pair.LeftContent,
+				RightID:      pair.RightID,
+				RightContent: pair.RightContent,
 			}
 		}
 
+		acceptedAnswers := make([]*response.AcceptedAnswer, len(question.AcceptedAnswers))
+
+		for j, answer := range question.AcceptedAnswers {
+			acceptedAnswers[j] = &response.AcceptedAnswer{
+				ID:      answer.ID,
+				Content: answer.Content,
+				IsRegex: answer.IsRegex,
+			}
+		}
+
+		units := make([]string, len(question.Units))
+
+		for j, unit := range question.Units {
+			units[j] = unit.Name
+		}
+
+		attachments, err := toAttachmentResponses(ctx, s.fileStorage, question.AttachmentsOf(0))
+		if err != nil {
+			return nil, err
+		}
+
 		questions[i] = &response.Question{
-			ID:      question.ID,
-			Content: question.Content,
-			Slug:    question.Slug,
-			Choices: choices,
+			ID:                  question.ID,
+			Type:                question.Type,
+			Content:             question.Content,
+			ContentFormat:       question.ContentFormat,
+			Rationale:           question.Rationale,
+			Tag:                 question.Tag,
+			Slug:                question.Slug,
+			Position:            question.Position,
+			Points:              question.Points,
+			CaseSensitive:       question.CaseSensitive,
+			DiacriticsSensitive: question.DiacriticsSensitive,
+			Choices:             choices,
+			Pairs:               pairs,
+			AcceptedAnswers:     acceptedAnswers,
+			NumericValue:        question.NumericValue,
+			Tolerance:           question.Tolerance,
+			ToleranceType:       question.ToleranceType,
+			Units:               units,
+			Attachments:         attachments,
+			BankQuestionID:      question.BankQuestionID,
 		}
 	}
 
 	return &response.ModuleDetail{
-		ID:          module.ID,
-		Title:       module.Title,
-		Slug:        module.Slug,
-		Description: module.Description,
-		Type:        module.Type,
-		IsPublished: module.IsPublished,
+		ID:                 module.ID,
+		Title:              module.Title,
+		Slug:               module.Slug,
+		Description:        module.Description,
+		Type:               module.Type,
+		ScoringPolicy:      module.ScoringPolicy,
+		WrongAnswerPenalty: module.WrongAnswerPenalty,
+		ShuffleQuestions:   module.ShuffleQuestions,
+		ShuffleChoices:     module.ShuffleChoices,
+		DrawCount:          module.DrawCount,
+		StratifyDraw:       module.StratifyDraw,
+		TimeLimitMinutes:   module.TimeLimitMinutes,
+		OpensAt:            module.OpensAt,
+		ClosesAt:           module.ClosesAt,
+		IsPublished:        module.IsPublished,
+		PublishedVersion:   module.PublishedVersion,
 		Subject: &response.Subject{
 			ID:   module.SubjectID,
 			Name: subjectName,
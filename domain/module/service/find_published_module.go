@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"github.com/arvinpaundra/private-api/domain/module/repository"
 	"github.com/arvinpaundra/private-api/domain/module/response"
@@ -29,21 +30,38 @@ func (s *FindPublishedModule) Execute(ctx context.Context, command *FindPublishe
 		return nil, err
 	}
 
-	totalQuestions, err := s.moduleReader.CountQuestionsByModuleSlug(ctx, module.Slug)
+	// Students only see the module while it is open
+	err = module.Availability(time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	// Count the questions each student is served and show the settings they are served with, not the draft
+	version, err := s.moduleReader.FindPublishedVersion(ctx, module.Slug, module.PublishedVersion)
 	if err != nil {
 		return nil, err
 	}
 
 	result := &response.Module{
-		ID:             module.ID,
-		UserID:         module.UserID,
-		SubjectID:      module.SubjectID,
-		GradeID:        module.GradeID,
-		Slug:           module.Slug,
-		Title:          module.Title,
-		Type:           module.Type,
-		IsPublished:    module.IsPublished,
-		QuestionsCount: totalQuestions,
+		ID:                 module.ID,
+		UserID:             module.UserID,
+		SubjectID:          module.SubjectID,
+		GradeID:            module.GradeID,
+		Slug:               module.Slug,
+		Title:              module.Title,
+		Type:               module.Type,
+		ScoringPolicy:      module.ScoringPolicy,
+		WrongAnswerPenalty: version.WrongAnswerPenalty,
+		ShuffleQuestions:   module.ShuffleQuestions,
+		ShuffleChoices:     module.ShuffleChoices,
+		DrawCount:          version.DrawCount,
+		StratifyDraw:       version.StratifyDraw,
+		TimeLimitMinutes:   module.TimeLimitMinutes,
+		OpensAt:            module.OpensAt,
+		ClosesAt:           module.ClosesAt,
+		IsPublished:        module.IsPublished,
+		PublishedVersion:   module.PublishedVersion,
+		QuestionsCount:     version.DrawSize(),
 	}
 
 	return result, nil
@@ -2,9 +2,13 @@ package service
 
 import (
 	"context"
-	"errors"
+	"slices"
+	"time"
 
+	"github.com/arvinpaundra/private-api/core/storage"
+	"github.com/arvinpaundra/private-api/core/util"
 	"github.com/arvinpaundra/private-api/domain/module/constant"
+	"github.com/arvinpaundra/private-api/domain/module/entity"
 	"github.com/arvinpaundra/private-api/domain/module/repository"
 	"github.com/arvinpaundra/private-api/domain/module/response"
 )
@@ -12,60 +16,170 @@ import (
 type FindPublishedQuestionCommand struct {
 	ModuleSlug   string `form:"-" validate:"required"`
 	QuestionSlug string `form:"-" validate:"required"`
+	Version      int    `form:"version" validate:"min=0"`
+
+	// SubmissionCode serves the version the submission started on, and its choices in its own order when the
+	// module shuffles them. It is required when the module draws questions, only the questions it drew are served,
+	// and when the module is timed, questions are only served while the submission has time left.
+	SubmissionCode string `form:"submission"`
 }
 
 type FindPublishedQuestion struct {
-	moduleReader repository.ModuleReader
+	moduleReader  repository.ModuleReader
+	submissionACL repository.SubmissionACL
+	fileStorage   storage.Storage
 }
 
 func NewFindPublishedQuestion(
 	moduleReader repository.ModuleReader,
+	submissionACL repository.SubmissionACL,
+	fileStorage storage.Storage,
 ) *FindPublishedQuestion {
 	return &FindPublishedQuestion{
-		moduleReader: moduleReader,
+		moduleReader:  moduleReader,
+		submissionACL: submissionACL,
+		fileStorage:   fileStorage,
 	}
 }
 
 func (s *FindPublishedQuestion) Execute(ctx context.Context, command *FindPublishedQuestionCommand) (*response.QuestionDetail, error) {
-	// Verify module exists and is published
 	module, err := s.moduleReader.FindPublishedModuleBySlug(ctx, command.ModuleSlug)
 	if err != nil {
 		return nil, err
 	}
 
-	// Find the specific question
-	question, err := s.moduleReader.FindPublishedQuestionBySlug(ctx, module.Slug, command.QuestionSlug)
+	var submission *entity.Submission
+	var questionSeed int64
+	var questionIDs []string
+	var remainingSeconds *int
+
+	// The currently served version unless one is given
+	versionNumber := command.Version
+
+	// A submission is only served the version it started on and the questions it drew, in its order,
+	// with the time it has left to answer
+	if command.SubmissionCode != "" {
+		submission, err = s.submissionACL.GetSubmission(ctx, module.ID, command.SubmissionCode)
+		if err != nil {
+			return nil, err
+		}
+
+		// A finalized submission, or one whose time is up, is served nothing more
+		err = submission.CheckInProgress(time.Now())
+		if err != nil {
+			return nil, err
+		}
+
+		if command.Version != 0 && command.Version != submission.ModuleVersion {
+			return nil, constant.ErrVersionMismatch
+		}
+
+		versionNumber = submission.ModuleVersion
+		questionSeed = submission.QuestionSeed
+		questionIDs = submission.QuestionIDs
+		remainingSeconds = submission.RemainingSeconds(time.Now())
+	} else if module.IsTimed() {
+		// Questions of a timed module are only served against the clock of a submission
+		return nil, constant.ErrSubmissionRequired
+	}
+
+	version, err := s.moduleReader.FindPublishedVersion(ctx, module.Slug, versionNumber)
 	if err != nil {
 		return nil, err
 	}
 
-	// Get next question for cursor
-	nextQuestion, err := s.moduleReader.FindNextPublishedQuestion(ctx, module.Slug, command.QuestionSlug)
-	if err != nil && !errors.Is(err, constant.ErrQuestionNotFound) {
+	if submission == nil && version.DrawsQuestions() {
+		return nil, constant.ErrSubmissionRequired
+	}
+
+	// Find the specific question
+	question, err := version.FindQuestionBySlug(command.QuestionSlug)
+	if err != nil {
 		return nil, err
 	}
 
+	if len(questionIDs) > 0 && !slices.Contains(questionIDs, question.ID) {
+		return nil, constant.ErrQuestionNotFound
+	}
+
 	var nextQuestionSlug *string
 
-	if nextQuestion != nil {
+	// Get next question for cursor, following the order the submission is served
+	if nextQuestion := version.NextQuestion(question.Slug, questionSeed, questionIDs); nextQuestion != nil {
 		nextQuestionSlug = &nextQuestion.Slug
 	}
 
-	// Build response
-	choices := make([]*response.Choice, len(question.Choices))
+	// Content is sanitized again on the way out, versions published before sanitizing may still hold raw HTML
+	questionChoices := question.ChoicesFor(version.ChoiceSeed(submission, question.Slug))
+	choices := make([]*response.Choice, len(questionChoices))
+
+	for i, choice := range questionChoices {
+		attachments, err := toAttachmentResponses(ctx, s.fileStorage, question.AttachmentsOf(choice.Position))
+		if err != nil {
+			return nil, err
+		}
 
-	for i, choice := range question.Choices {
 		choices[i] = &response.Choice{
-			ID:      choice.ID,
-			Content: choice.Content,
+			ID:          choice.ID,
+			Content:     question.SanitizeContent(choice.Content),
+			Attachments: attachments,
 		}
 	}
 
+	itemSeed := version.ItemSeed(command.SubmissionCode, question.Slug)
+
+	// Ordering items are served shuffled so their position gives nothing away
+	if question.IsOrdering() {
+		util.SeededShuffle(itemSeed, len(choices), func(i, j int) {
+			choices[i], choices[j] = choices[j], choices[i]
+		})
+	}
+
+	// Matching items are served as two lists, the right side shuffled
+	var leftItems, rightItems []*response.MatchingItem
+
+	for _, pair := range question.Pairs {
+		leftItems = append(leftItems, &response.MatchingItem{
+			ID:      pair.ID,
+			Content: question.SanitizeContent(pair.LeftContent),
+		})
+
+		rightItems = append(rightItems, &response.MatchingItem{
+			ID:      pair.RightID,
+			Content: question.SanitizeContent(pair.RightContent),
+		})
+	}
+
+	util.SeededShuffle(itemSeed, len(rightItems), func(i, j int) {
+		rightItems[i], rightItems[j] = rightItems[j], rightItems[i]
+	})
+
+	// Units are listed so the student knows which ones are accepted
+	var units []string
+
+	for _, unit := range question.Units {
+		units = append(units, unit.Name)
+	}
+
+	attachments, err := toAttachmentResponses(ctx, s.fileStorage, question.AttachmentsOf(0))
+	if err != nil {
+		return nil, err
+	}
+
 	return &response.QuestionDetail{
 		ID:               question.ID,
-		Content:          question.Content,
+		Type:             question.Type,
+		Content:          question.SanitizeContent(question.Content),
+		ContentFormat:    question.ContentFormat,
 		Slug:             question.Slug,
+		Points:           question.Points,
 		Choices:          choices,
+		LeftItems:        leftItems,
+		RightItems:       rightItems,
+		Units:            units,
+		Attachments:      attachments,
 		NextQuestionSlug: nextQuestionSlug,
+		Version:          version.Version,
+		RemainingSeconds: remainingSeconds,
 	}, nil
 }
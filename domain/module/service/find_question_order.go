@@ -0,0 +1,46 @@
+package service
+
+import (
+	"context"
+
+	"github.com/arvinpaundra/private-api/domain/module/repository"
+)
+
+type FindQuestionOrderCommand struct {
+	ModuleSlug string `validate:"required"`
+	Version    int    `validate:"min=0"`
+	Seed       int64  `validate:"min=0"`
+
+	// QuestionIDs are the questions the submission drew, every question when empty
+	QuestionIDs []string
+}
+
+type FindQuestionOrder struct {
+	moduleReader repository.ModuleReader
+}
+
+func NewFindQuestionOrder(
+	moduleReader repository.ModuleReader,
+) *FindQuestionOrder {
+	return &FindQuestionOrder{
+		moduleReader: moduleReader,
+	}
+}
+
+// Execute lists the question slugs a submission drew from a published version, in the order
+// a submission with the given seed is served them
+func (s *FindQuestionOrder) Execute(ctx context.Context, command *FindQuestionOrderCommand) ([]string, error) {
+	version, err := s.moduleReader.FindPublishedVersion(ctx, command.ModuleSlug, command.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	questions := version.QuestionsFor(command.Seed, command.QuestionIDs)
+
+	slugs := make([]string, len(questions))
+	for i, question := range questions {
+		slugs[i] = question.Slug
+	}
+
+	return slugs, nil
+}
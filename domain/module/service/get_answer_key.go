@@ -0,0 +1,130 @@
+package service
+
+import (
+	"context"
+
+	"github.com/arvinpaundra/private-api/domain/module/constant"
+	"github.com/arvinpaundra/private-api/domain/module/entity"
+	"github.com/arvinpaundra/private-api/domain/module/repository"
+	"github.com/arvinpaundra/private-api/domain/module/response"
+)
+
+type GetAnswerKeyCommand struct {
+	ModuleSlug   string `validate:"required"`
+	QuestionSlug string `validate:"required"`
+	Version      int    `validate:"min=0"`
+
+	// SubmissionCode lists the choices in the order that submission was served them
+	SubmissionCode string
+}
+
+type GetAnswerKey struct {
+	moduleReader  repository.ModuleReader
+	submissionACL repository.SubmissionACL
+}
+
+func NewGetAnswerKey(
+	moduleReader repository.ModuleReader,
+	submissionACL repository.SubmissionACL,
+) *GetAnswerKey {
+	return &GetAnswerKey{
+		moduleReader:  moduleReader,
+		submissionACL: submissionACL,
+	}
+}
+
+func (s *GetAnswerKey) Execute(ctx context.Context, command *GetAnswerKeyCommand) (*response.AnswerKey, error) {
+	// Grade against the version the answer was given on
+	version, err := s.moduleReader.FindPublishedVersion(ctx, command.ModuleSlug, command.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	// Find the specific question
+	question, err := version.FindQuestionBySlug(command.QuestionSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	// Content is sanitized on the way out the same as when the question is served,
+	// versions published before sanitizing may still hold raw HTML
+	answerKey := &response.AnswerKey{
+		Type:               question.Type,
+		Points:             question.Points,
+		WrongAnswerPenalty: version.WrongAnswerPenalty,
+		Rationale:          question.SanitizeContent(question.Rationale),
+	}
+
+	// Fill only the part of the key the question type is graded with
+	switch question.Type {
+	case constant.Matching:
+		answerKey.Pairs = make([]*response.PairWithAnswer, len(question.Pairs))
+
+		for i, pair := range question.Pairs {
+			answerKey.Pairs[i] = &response.PairWithAnswer{
+				ID:           pair.ID,
+				LeftContent:  question.SanitizeContent(pair.LeftContent),
+				RightID:      pair.RightID,
+				RightContent: question.SanitizeContent(pair.RightContent),
+			}
+		}
+	case constant.ShortAnswer:
+		acceptedAnswers := make([]*response.AcceptedAnswer, len(question.AcceptedAnswers))
+
+		for i, answer := range question.AcceptedAnswers {
+			acceptedAnswers[i] = &response.AcceptedAnswer{
+				ID:      answer.ID,
+				Content: answer.Content,
+				IsRegex: answer.IsRegex,
+			}
+		}
+
+		answerKey.Text = &response.TextAnswerKey{
+			CaseSensitive:       question.CaseSensitive,
+			DiacriticsSensitive: question.DiacriticsSensitive,
+			AcceptedAnswers:     acceptedAnswers,
+		}
+	case constant.Numeric:
+		if question.NumericValue == nil {
+			return nil, constant.ErrNumericValueRequired
+		}
+
+		units := make([]string, len(question.Units))
+
+		for i, unit := range question.Units {
+			units[i] = unit.Name
+		}
+
+		answerKey.Numeric = &response.NumericAnswerKey{
+			Value:         *question.NumericValue,
+			Tolerance:     question.Tolerance,
+			ToleranceType: question.ToleranceType,
+			Units:         units,
+		}
+	default:
+		var submission *entity.Submission
+
+		// The choices are listed in the order the submission settled on when it started
+		if command.SubmissionCode != "" {
+			submission, err = s.submissionACL.GetSubmission(ctx, version.ModuleID, command.SubmissionCode)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		questionChoices := question.ChoicesFor(version.ChoiceSeed(submission, question.Slug))
+		answerKey.Choices = make([]*response.ChoiceWithAnswer, len(questionChoices))
+
+		for i, choice := range questionChoices {
+			answerKey.Choices[i] = &response.ChoiceWithAnswer{
+				ID:              choice.ID,
+				Content:         question.SanitizeContent(choice.Content),
+				IsCorrectAnswer: choice.IsCorrectAnswer,
+				CorrectPosition: choice.CorrectPosition,
+				Explanation:     question.SanitizeContent(choice.Explanation),
+			}
+		}
+	}
+
+	return answerKey, nil
+}
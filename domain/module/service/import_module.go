@@ -0,0 +1,152 @@
+package service
+
+import (
+	"context"
+
+	"github.com/arvinpaundra/private-api/domain/module/constant"
+	"github.com/arvinpaundra/private-api/domain/module/entity"
+	"github.com/arvinpaundra/private-api/domain/module/repository"
+	"github.com/arvinpaundra/private-api/domain/shared/interfaces"
+)
+
+// ImportModuleCommand reads the document produced by ExportModule. Fields added by later
+// schema versions are optional, so older exports decode with their defaults.
+type ImportModuleCommand struct {
+	SchemaVersion int             `json:"schema_version" validate:"required,min=1"`
+	Module        *ImportedModule `json:"module" validate:"required"`
+}
+
+type ImportedModule struct {
+	Title              string                 `json:"title" validate:"required,max=100"`
+	Description        *string                `json:"description,omitempty"`
+	Subject            string                 `json:"subject" validate:"required,max=100"`
+	Grade              string                 `json:"grade" validate:"required,max=100"`
+	Type               constant.ModuleType    `json:"type" validate:"omitempty,oneof=multiple_choice matching_type"`
+	ScoringPolicy      constant.ScoringPolicy `json:"scoring_policy" validate:"omitempty,oneof=all_or_nothing proportional right_minus_wrong"`
+	WrongAnswerPenalty float64                `json:"wrong_answer_penalty" validate:"min=0,max=1"`
+	ShuffleQuestions   bool                   `json:"shuffle_questions"`
+	ShuffleChoices     bool                   `json:"shuffle_choices"`
+	DrawCount          int                    `json:"draw_count" validate:"min=0,max=500"`
+	StratifyDraw       bool                   `json:"stratify_draw"`
+	TimeLimitMinutes   int                    `json:"time_limit_minutes" validate:"min=0,max=1440"`
+	Questions          []*AddQuestion         `json:"questions" validate:"max=500,dive"`
+}
+
+type ImportModule struct {
+	authStorage interfaces.AuthenticatedUser
+	uow         repository.UnitOfWork
+	subjectACL  repository.SubjectACL
+	gradeACL    repository.GradeACL
+}
+
+func NewImportModule(
+	authStorage interfaces.AuthenticatedUser,
+	uow repository.UnitOfWork,
+	subjectACL repository.SubjectACL,
+	gradeACL repository.GradeACL,
+) *ImportModule {
+	return &ImportModule{
+		authStorage: authStorage,
+		uow:         uow,
+		subjectACL:  subjectACL,
+		gradeACL:    gradeACL,
+	}
+}
+
+func (s *ImportModule) Execute(ctx context.Context, command *ImportModuleCommand) (string, error) {
+	if command.SchemaVersion > constant.ExportSchemaVersion {
+		return "", constant.ErrUnsupportedSchemaVersion
+	}
+
+	source := command.Module
+
+	// default to multiple choice when no type is given
+	moduleType := source.Type
+	if moduleType == "" {
+		moduleType = constant.MultipleChoice
+	}
+
+	// default to all or nothing when no scoring policy is given
+	scoringPolicy := source.ScoringPolicy
+	if scoringPolicy == "" {
+		scoringPolicy = constant.AllOrNothing
+	}
+
+	// Build and validate every question before any subject or grade is created
+	draft, err := entity.NewModule(s.authStorage.GetUserId(), "", "", source.Title, source.Description, moduleType, scoringPolicy, entity.ModuleSettings{
+		WrongAnswerPenalty: source.WrongAnswerPenalty,
+		ShuffleQuestions:   source.ShuffleQuestions,
+		ShuffleChoices:     source.ShuffleChoices,
+		DrawCount:          source.DrawCount,
+		StratifyDraw:       source.StratifyDraw,
+		TimeLimitMinutes:   source.TimeLimitMinutes,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	for i, questionCmd := range source.Questions {
+		// Fall back to the module's default question type
+		questionType := questionCmd.Type
+		if questionType == "" {
+			questionType = draft.DefaultQuestionType()
+		}
+
+		question, err := entity.NewQuestion(draft.ID, questionCmd.Content, questionCmd.ContentFormat, questionType)
+		if err != nil {
+			return "", err
+		}
+
+		question.UpdatePosition(i + 1)
+
+		// Add answers to question
+		addAnswers(question, questionCmd)
+		question.UpdateTag(questionCmd.Tag)
+
+		// Validate answers against the module type
+		err = draft.ValidateQuestion(question)
+		if err != nil {
+			return "", err
+		}
+
+		draft.AddQuestion(question)
+	}
+
+	// Map the names onto the importer's own subject and grade
+	subjectID, err := s.subjectACL.FindOrCreateSubject(ctx, source.Subject, s.authStorage.GetUserId())
+	if err != nil {
+		return "", err
+	}
+
+	gradeID, err := s.gradeACL.FindOrCreateGrade(ctx, source.Grade, s.authStorage.GetUserId())
+	if err != nil {
+		return "", err
+	}
+
+	module, err := draft.Clone(s.authStorage.GetUserId(), subjectID, gradeID, source.Title)
+	if err != nil {
+		return "", err
+	}
+
+	// Begin transaction
+	tx, err := s.uow.Begin()
+	if err != nil {
+		return "", err
+	}
+
+	// Save module (which will cascade to questions)
+	err = tx.ModuleWriter().Save(ctx, module)
+	if err != nil {
+		if uowErr := tx.Rollback(); uowErr != nil {
+			return "", uowErr
+		}
+		return "", err
+	}
+
+	// Commit transaction
+	if err := tx.Commit(); err != nil {
+		return "", err
+	}
+
+	return module.Slug, nil
+}
@@ -0,0 +1,157 @@
+package service
+
+import (
+	"context"
+	"io"
+
+	"github.com/arvinpaundra/private-api/core/qti"
+	"github.com/arvinpaundra/private-api/domain/module/constant"
+	"github.com/arvinpaundra/private-api/domain/module/entity"
+	"github.com/arvinpaundra/private-api/domain/module/repository"
+	"github.com/arvinpaundra/private-api/domain/module/response"
+	"github.com/arvinpaundra/private-api/domain/shared/interfaces"
+)
+
+type ImportPackageCommand struct {
+	Title     string    `form:"title" validate:"required,max=100"`
+	SubjectID string    `form:"subject_id" validate:"required"`
+	GradeID   string    `form:"grade_id" validate:"required"`
+	File      io.Reader `form:"-"`
+}
+
+type ImportPackage struct {
+	authStorage interfaces.AuthenticatedUser
+	uow         repository.UnitOfWork
+	subjectACL  repository.SubjectACL
+	gradeACL    repository.GradeACL
+}
+
+func NewImportPackage(
+	authStorage interfaces.AuthenticatedUser,
+	uow repository.UnitOfWork,
+	subjectACL repository.SubjectACL,
+	gradeACL repository.GradeACL,
+) *ImportPackage {
+	return &ImportPackage{
+		authStorage: authStorage,
+		uow:         uow,
+		subjectACL:  subjectACL,
+		gradeACL:    gradeACL,
+	}
+}
+
+// Execute creates a multiple choice module from the choice items of a QTI 2.1 content package.
+// Items with another interaction, or that do not make a valid question, are skipped and reported.
+func (s *ImportPackage) Execute(ctx context.Context, command *ImportPackageCommand) (*response.PackageImport, error) {
+	data, err := io.ReadAll(io.LimitReader(command.File, constant.MaxPackageSize+1))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) > constant.MaxPackageSize {
+		return nil, constant.ErrPackageTooLarge
+	}
+
+	items, skipped, err := qti.ReadPackage(data)
+	if err != nil {
+		return nil, constant.ErrInvalidPackage
+	}
+
+	if len(items)+len(skipped) > constant.MaxImportRows {
+		return nil, constant.ErrMaxImportRows
+	}
+
+	// check if subject exists via ACL
+	isSubjectExist, err := s.subjectACL.IsSubjectExist(ctx, command.SubjectID, s.authStorage.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	if !isSubjectExist {
+		return nil, constant.ErrSubjectNotFound
+	}
+
+	// check if grade exists via ACL
+	isGradeExist, err := s.gradeACL.IsGradeExist(ctx, command.GradeID, s.authStorage.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	if !isGradeExist {
+		return nil, constant.ErrGradeNotFound
+	}
+
+	// Collect the questions on a draft, cloned into the new module once they are all read
+	draft, err := entity.NewModule(s.authStorage.GetUserId(), command.SubjectID, command.GradeID, command.Title, nil, constant.MultipleChoice, constant.AllOrNothing, entity.ModuleSettings{})
+	if err != nil {
+		return nil, err
+	}
+
+	result := &response.PackageImport{
+		Skipped: make(map[string]string),
+	}
+
+	for _, item := range skipped {
+		result.Skipped[item.Href] = item.Reason
+	}
+
+	for _, item := range items {
+		questionCmd, err := fromQTIItem(item)
+		if err != nil {
+			result.Skipped[item.Href] = err.Error()
+			continue
+		}
+
+		question, err := entity.NewQuestion(draft.ID, questionCmd.Content, questionCmd.ContentFormat, questionCmd.Type)
+		if err != nil {
+			return nil, err
+		}
+
+		question.UpdatePosition(draft.NextQuestionPosition())
+
+		addAnswers(question, questionCmd)
+
+		// Validate answers against the module type
+		err = draft.ValidateQuestion(question)
+		if err != nil {
+			result.Skipped[item.Href] = err.Error()
+			continue
+		}
+
+		draft.AddQuestion(question)
+		result.Imported++
+	}
+
+	if result.Imported == 0 {
+		return result, constant.ErrNoImportableItems
+	}
+
+	module, err := draft.Clone(s.authStorage.GetUserId(), command.SubjectID, command.GradeID, command.Title)
+	if err != nil {
+		return nil, err
+	}
+
+	// Begin transaction
+	tx, err := s.uow.Begin()
+	if err != nil {
+		return nil, err
+	}
+
+	// Save module (which will cascade to questions)
+	err = tx.ModuleWriter().Save(ctx, module)
+	if err != nil {
+		if uowErr := tx.Rollback(); uowErr != nil {
+			return nil, uowErr
+		}
+		return nil, err
+	}
+
+	// Commit transaction
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	result.Slug = module.Slug
+
+	return result, nil
+}
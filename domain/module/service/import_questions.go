@@ -0,0 +1,359 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/arvinpaundra/private-api/core/spreadsheet"
+	"github.com/arvinpaundra/private-api/core/util"
+	"github.com/arvinpaundra/private-api/domain/module/constant"
+	"github.com/arvinpaundra/private-api/domain/module/entity"
+	"github.com/arvinpaundra/private-api/domain/module/repository"
+	"github.com/arvinpaundra/private-api/domain/module/response"
+	"github.com/arvinpaundra/private-api/domain/shared/interfaces"
+)
+
+type ImportQuestionsCommand struct {
+	ModuleSlug string `form:"-" validate:"required"`
+	DryRun     bool   `form:"dry_run"`
+
+	// Format is taken from the file extension when empty, Aiken files always name it
+	Format constant.FileFormat `form:"format" validate:"omitempty,oneof=csv xlsx gift aiken"`
+
+	Filename string    `form:"-" validate:"required,max=255"`
+	File     io.Reader `form:"-"`
+}
+
+// importedQuestion is a question read from the file, or the reason it could not be read
+type importedQuestion struct {
+	key      string // where the question sits in the file, such as rows[3] or lines[12]
+	question *AddQuestion
+	err      error
+}
+
+type ImportQuestions struct {
+	authStorage  interfaces.AuthenticatedUser
+	moduleReader repository.ModuleReader
+	uow          repository.UnitOfWork
+}
+
+func NewImportQuestions(
+	authStorage interfaces.AuthenticatedUser,
+	moduleReader repository.ModuleReader,
+	uow repository.UnitOfWork,
+) *ImportQuestions {
+	return &ImportQuestions{
+		authStorage:  authStorage,
+		moduleReader: moduleReader,
+		uow:          uow,
+	}
+}
+
+func (s *ImportQuestions) Execute(ctx context.Context, command *ImportQuestionsCommand) (*response.ImportQuestions, error) {
+	data, err := io.ReadAll(io.LimitReader(command.File, constant.MaxImportFileSize+1))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) > constant.MaxImportFileSize {
+		return nil, constant.ErrImportFileTooLarge
+	}
+
+	format := command.Format
+	if format == "" {
+		format = constant.FileFormat(strings.TrimPrefix(strings.ToLower(filepath.Ext(command.Filename)), "."))
+	}
+
+	var imported []*importedQuestion
+
+	switch format {
+	case constant.CSVFile, constant.XLSXFile:
+		imported, err = readSpreadsheetQuestions(format, data)
+	case constant.GIFTFile, constant.AikenFile:
+		imported, err = readMoodleQuestions(format, data)
+	default:
+		err = constant.ErrUnsupportedImportFile
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(imported) == 0 {
+		return nil, constant.ErrEmptyImport
+	}
+
+	if len(imported) > constant.MaxImportRows {
+		return nil, constant.ErrMaxImportRows
+	}
+
+	// Load module with its questions, scoped to the user
+	module, err := s.moduleReader.FindModuleDetailBySlug(ctx, command.ModuleSlug, s.authStorage.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	result := &response.ImportQuestions{
+		DryRun: command.DryRun,
+		Errors: make(map[string]string),
+	}
+
+	// New questions are appended after the existing ones
+	nextPosition := module.NextQuestionPosition()
+
+	// Every question is checked so the teacher gets the full report at once
+	for _, item := range imported {
+		result.Total++
+
+		if item.err != nil {
+			result.Errors[item.key] = item.err.Error()
+			continue
+		}
+
+		questionCmd := item.question
+
+		// Fall back to the module's default question type
+		questionType := questionCmd.Type
+		if questionType == "" {
+			questionType = module.DefaultQuestionType()
+		}
+
+		question, err := entity.NewQuestion(module.ID, questionCmd.Content, questionCmd.ContentFormat, questionType)
+		if err != nil {
+			return nil, err
+		}
+
+		question.UpdatePosition(nextPosition)
+		nextPosition++
+
+		addAnswers(question, questionCmd)
+
+		// Validate answers against the module type
+		err = module.ValidateQuestion(question)
+		if err != nil {
+			result.Errors[item.key] = err.Error()
+			continue
+		}
+
+		module.AddQuestion(question)
+	}
+
+	if len(result.Errors) > 0 {
+		return result, constant.ErrInvalidImportRows
+	}
+
+	if command.DryRun {
+		return result, nil
+	}
+
+	// Begin transaction
+	tx, err := s.uow.Begin()
+	if err != nil {
+		return nil, err
+	}
+
+	// Save module (which will cascade to questions)
+	err = tx.ModuleWriter().Save(ctx, module)
+	if err != nil {
+		if uowErr := tx.Rollback(); uowErr != nil {
+			return nil, uowErr
+		}
+		return nil, err
+	}
+
+	// Commit transaction
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	result.Imported = result.Total
+
+	return result, nil
+}
+
+// readSpreadsheetQuestions reads one question per row below the header row
+func readSpreadsheetQuestions(format constant.FileFormat, data []byte) ([]*importedQuestion, error) {
+	read := spreadsheet.ReadCSV
+	if format == constant.XLSXFile {
+		read = spreadsheet.ReadXLSX
+	}
+
+	rows, err := read(data)
+	if err != nil {
+		return nil, constant.ErrUnreadableImportFile
+	}
+
+	if len(rows) == 0 {
+		return nil, constant.ErrEmptyImport
+	}
+
+	columns, err := findImportColumns(rows[0])
+	if err != nil {
+		return nil, err
+	}
+
+	var imported []*importedQuestion
+
+	for i, row := range rows[1:] {
+		if isBlankRow(row) {
+			continue
+		}
+
+		questionCmd, err := columns.toAddQuestion(row)
+
+		imported = append(imported, &importedQuestion{
+			// Rows are numbered the way the spreadsheet shows them, the header being row 1
+			key:      fmt.Sprintf("rows[%d]", i+2),
+			question: questionCmd,
+			err:      err,
+		})
+	}
+
+	return imported, nil
+}
+
+// importColumns holds the position of each known header, -1 when the spreadsheet does not have it
+type importColumns struct {
+	questionType  int
+	content       int
+	contentFormat int
+	points        int
+	rationale     int
+	correct       int
+	choices       []int
+}
+
+func findImportColumns(header []string) (*importColumns, error) {
+	columns := &importColumns{
+		questionType:  -1,
+		content:       -1,
+		contentFormat: -1,
+		points:        -1,
+		rationale:     -1,
+		correct:       -1,
+	}
+
+	for i, cell := range header {
+		name := strings.ReplaceAll(strings.ToLower(strings.TrimSpace(cell)), " ", "_")
+
+		switch {
+		case name == "type":
+			columns.questionType = i
+		case name == "content" || name == "question":
+			columns.content = i
+		case name == "content_format":
+			columns.contentFormat = i
+		case name == "points":
+			columns.points = i
+		case name == "rationale":
+			columns.rationale = i
+		case name == "correct" || name == "correct_answer":
+			columns.correct = i
+		case strings.HasPrefix(name, "choice"):
+			columns.choices = append(columns.choices, i)
+		}
+	}
+
+	if columns.content < 0 || columns.correct < 0 || len(columns.choices) == 0 {
+		return nil, constant.ErrMissingImportColumns
+	}
+
+	return columns, nil
+}
+
+// toAddQuestion reads a row the same way a question is received in AddQuestions
+func (c *importColumns) toAddQuestion(row []string) (*AddQuestion, error) {
+	questionCmd := &AddQuestion{
+		Type:          constant.QuestionType(strings.ToLower(cell(row, c.questionType))),
+		Content:       cell(row, c.content),
+		ContentFormat: constant.ContentFormat(strings.ToLower(cell(row, c.contentFormat))),
+		Rationale:     cell(row, c.rationale),
+	}
+
+	// Only choice questions fit a row of choices and a correct column
+	switch questionCmd.Type {
+	case "", constant.SingleChoice, constant.MultipleSelect:
+	default:
+		return nil, fmt.Errorf("type: must be one of single_choice, multiple_select")
+	}
+
+	if questionCmd.Content == "" {
+		return nil, fmt.Errorf("content: this field is required")
+	}
+
+	switch questionCmd.ContentFormat {
+	case "", constant.PlainText, constant.Markdown:
+	default:
+		return nil, fmt.Errorf("content_format: must be one of plain, markdown")
+	}
+
+	if points := cell(row, c.points); points != "" {
+		value, err := util.ParseDecimal(points)
+		if err != nil || value < 0 || value > 100 {
+			return nil, fmt.Errorf("points: must be a number between 0 and 100")
+		}
+
+		questionCmd.Points = value
+	}
+
+	correct := make(map[int]bool)
+
+	for _, reference := range strings.FieldsFunc(cell(row, c.correct), func(r rune) bool {
+		return r == ',' || r == ';' || r == ' '
+	}) {
+		position, ok := choicePosition(reference, len(c.choices))
+		if !ok || cell(row, c.choices[position-1]) == "" {
+			return nil, fmt.Errorf("correct: %q does not name a filled choice", reference)
+		}
+
+		correct[position] = true
+	}
+
+	for i, column := range c.choices {
+		content := cell(row, column)
+		if content == "" {
+			continue
+		}
+
+		questionCmd.Choices = append(questionCmd.Choices, &AddQuestionChoice{
+			Content:         content,
+			IsCorrectAnswer: correct[i+1],
+		})
+	}
+
+	return questionCmd, nil
+}
+
+// choicePosition reads a correct answer given as a choice number (1, 2, ...) or letter (A, B, ...)
+func choicePosition(reference string, total int) (int, bool) {
+	position := 0
+
+	if len(reference) == 1 && strings.ContainsAny(strings.ToUpper(reference), "ABCDEFGHIJ") {
+		position = int(strings.ToUpper(reference)[0]-'A') + 1
+	} else if _, err := fmt.Sscanf(reference, "%d", &position); err != nil || fmt.Sprint(position) != reference {
+		return 0, false
+	}
+
+	return position, position >= 1 && position <= total
+}
+
+func cell(row []string, column int) string {
+	if column < 0 || column >= len(row) {
+		return ""
+	}
+
+	return strings.TrimSpace(row[column])
+}
+
+func isBlankRow(row []string) bool {
+	for _, value := range row {
+		if strings.TrimSpace(value) != "" {
+			return false
+		}
+	}
+
+	return true
+}
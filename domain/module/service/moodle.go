@@ -0,0 +1,202 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/arvinpaundra/private-api/core/moodle"
+	"github.com/arvinpaundra/private-api/domain/module/constant"
+	"github.com/arvinpaundra/private-api/domain/module/entity"
+)
+
+// maxAnswerLength is the longest matching pair side or accepted answer that can be stored
+const maxAnswerLength = 255
+
+// readMoodleQuestions reads a GIFT or Aiken file, keying each question and each error by its line
+func readMoodleQuestions(format constant.FileFormat, data []byte) ([]*importedQuestion, error) {
+	if !utf8.Valid(data) {
+		return nil, constant.ErrUnreadableImportFile
+	}
+
+	parse := moodle.ParseGIFT
+	if format == constant.AikenFile {
+		parse = moodle.ParseAiken
+	}
+
+	questions, errs := parse(string(data))
+
+	imported := make([]*importedQuestion, 0, len(questions)+len(errs))
+
+	for _, err := range errs {
+		imported = append(imported, &importedQuestion{
+			key: fmt.Sprintf("lines[%d]", err.Line),
+			err: errors.New(err.Message),
+		})
+	}
+
+	for _, question := range questions {
+		questionCmd, err := fromMoodleQuestion(question)
+
+		imported = append(imported, &importedQuestion{
+			key:      fmt.Sprintf("lines[%d]", question.Line),
+			question: questionCmd,
+			err:      err,
+		})
+	}
+
+	return imported, nil
+}
+
+// fromMoodleQuestion describes a GIFT or Aiken question the same way a question is received in AddQuestions
+func fromMoodleQuestion(question *moodle.Question) (*AddQuestion, error) {
+	questionCmd := &AddQuestion{
+		Content:       question.Text,
+		ContentFormat: constant.PlainText,
+		Rationale:     question.Feedback,
+	}
+
+	if question.Markdown {
+		questionCmd.ContentFormat = constant.Markdown
+	}
+
+	switch question.Type {
+	case moodle.MultipleChoice, moodle.MultipleResponse:
+		questionCmd.Type = constant.SingleChoice
+		if question.Type == moodle.MultipleResponse {
+			questionCmd.Type = constant.MultipleSelect
+		}
+
+		for _, choice := range question.Choices {
+			questionCmd.Choices = append(questionCmd.Choices, &AddQuestionChoice{
+				Content:         choice.Text,
+				IsCorrectAnswer: choice.Correct,
+				Explanation:     choice.Feedback,
+			})
+		}
+	case moodle.ShortAnswer:
+		questionCmd.Type = constant.ShortAnswer
+
+		for _, answer := range question.Answers {
+			if utf8.RuneCountInString(answer) > maxAnswerLength {
+				return nil, fmt.Errorf("accepted answers must not be longer than %d characters", maxAnswerLength)
+			}
+
+			questionCmd.AcceptedAnswers = append(questionCmd.AcceptedAnswers, &AddQuestionAcceptedAnswer{
+				Content: answer,
+			})
+		}
+	case moodle.Numerical:
+		value := question.Value
+
+		questionCmd.Type = constant.Numeric
+		questionCmd.NumericValue = &value
+		questionCmd.Tolerance = question.Tolerance
+		questionCmd.ToleranceType = constant.AbsoluteTolerance
+	case moodle.Matching:
+		questionCmd.Type = constant.Matching
+
+		for _, pair := range question.Pairs {
+			if utf8.RuneCountInString(pair.Left) > maxAnswerLength || utf8.RuneCountInString(pair.Right) > maxAnswerLength {
+				return nil, fmt.Errorf("matching items must not be longer than %d characters", maxAnswerLength)
+			}
+
+			questionCmd.Pairs = append(questionCmd.Pairs, &AddQuestionPair{
+				LeftContent:  pair.Left,
+				RightContent: pair.Right,
+			})
+		}
+	}
+
+	return questionCmd, nil
+}
+
+// toMoodleQuestion writes a question in the terms of GIFT or Aiken, or tells why it cannot be.
+// Points and attachments have no place in either format and are left out.
+func toMoodleQuestion(question *entity.Question, format constant.FileFormat) (*moodle.Question, error) {
+	exported := &moodle.Question{
+		Text:     question.Content,
+		Markdown: question.IsMarkdown(),
+		Feedback: question.Rationale,
+	}
+
+	switch question.Type {
+	case constant.SingleChoice, constant.MultipleSelect:
+		exported.Type = moodle.MultipleChoice
+		if question.IsMultipleSelect() {
+			exported.Type = moodle.MultipleResponse
+		}
+
+		for _, choice := range question.Choices {
+			exported.Choices = append(exported.Choices, &moodle.Choice{
+				Text:     choice.Content,
+				Correct:  choice.IsCorrectAnswer,
+				Feedback: choice.Explanation,
+			})
+		}
+	case constant.ShortAnswer:
+		if question.CaseSensitive || question.DiacriticsSensitive {
+			return nil, fmt.Errorf("case or diacritics sensitive answers cannot be written as %s", format)
+		}
+
+		for _, answer := range question.AcceptedAnswers {
+			if answer.IsRegex {
+				return nil, fmt.Errorf("regular expression answers cannot be written as %s", format)
+			}
+
+			exported.Answers = append(exported.Answers, answer.Content)
+		}
+
+		exported.Type = moodle.ShortAnswer
+	case constant.Numeric:
+		if len(question.Units) > 0 {
+			return nil, fmt.Errorf("numeric answers with units cannot be written as %s", format)
+		}
+
+		if question.NumericValue != nil {
+			exported.Value = *question.NumericValue
+		}
+
+		// GIFT only knows an absolute margin
+		exported.Tolerance = question.Tolerance
+		if question.ToleranceType == constant.PercentageTolerance {
+			exported.Tolerance = math.Abs(exported.Value) * question.Tolerance / 100
+		}
+
+		exported.Type = moodle.Numerical
+	case constant.Matching:
+		for _, pair := range question.Pairs {
+			exported.Pairs = append(exported.Pairs, &moodle.Pair{
+				Left:  pair.LeftContent,
+				Right: pair.RightContent,
+			})
+		}
+
+		exported.Type = moodle.Matching
+	default:
+		return nil, fmt.Errorf("%s questions cannot be written as %s", question.Type, format)
+	}
+
+	if format != constant.AikenFile {
+		return exported, nil
+	}
+
+	// Aiken only holds single choice questions, one line each, without feedback
+	if exported.Type != moodle.MultipleChoice {
+		return nil, fmt.Errorf("%s questions cannot be written as aiken", question.Type)
+	}
+
+	if strings.Contains(exported.Text, "\n") {
+		return nil, errors.New("aiken questions must fit on one line")
+	}
+
+	for _, choice := range exported.Choices {
+		if strings.Contains(choice.Text, "\n") {
+			return nil, errors.New("aiken choices must fit on one line")
+		}
+	}
+
+	return exported, nil
+}
@@ -0,0 +1,63 @@
+package service
+
+import (
+	"context"
+
+	"github.com/arvinpaundra/private-api/domain/module/repository"
+	"github.com/arvinpaundra/private-api/domain/shared/interfaces"
+)
+
+type PublishModuleVersionCommand struct {
+	Slug string `json:"-" validate:"required"`
+}
+
+type PublishModuleVersion struct {
+	authStorage  interfaces.AuthenticatedUser
+	moduleReader repository.ModuleReader
+	uow          repository.UnitOfWork
+}
+
+func NewPublishModuleVersion(
+	authStorage interfaces.AuthenticatedUser,
+	moduleReader repository.ModuleReader,
+	uow repository.UnitOfWork,
+) *PublishModuleVersion {
+	return &PublishModuleVersion{
+		authStorage:  authStorage,
+		moduleReader: moduleReader,
+		uow:          uow,
+	}
+}
+
+func (s *PublishModuleVersion) Execute(ctx context.Context, command *PublishModuleVersionCommand) (int, error) {
+	// Load module with its draft questions, scoped to the user
+	module, err := s.moduleReader.FindModuleDetailBySlug(ctx, command.Slug, s.authStorage.GetUserId())
+	if err != nil {
+		return 0, err
+	}
+
+	// Freeze the draft as a new version, submissions already started keep theirs
+	module.PublishNewVersion()
+
+	// Begin transaction
+	tx, err := s.uow.Begin()
+	if err != nil {
+		return 0, err
+	}
+
+	// Save module (which will insert the version)
+	err = tx.ModuleWriter().Save(ctx, module)
+	if err != nil {
+		if uowErr := tx.Rollback(); uowErr != nil {
+			return 0, uowErr
+		}
+		return 0, err
+	}
+
+	// Commit transaction
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+
+	return module.PublishedVersion, nil
+}
@@ -0,0 +1,71 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/arvinpaundra/private-api/core/qti"
+	"github.com/arvinpaundra/private-api/domain/module/constant"
+	"github.com/arvinpaundra/private-api/domain/module/entity"
+)
+
+// toQTIItem writes a choice question as an assessment item, or tells why it cannot be.
+// Content is written as it is stored, explanations, rationale and attachments are left out.
+func toQTIItem(question *entity.Question) (*qti.Item, error) {
+	if question.Type != constant.SingleChoice && question.Type != constant.MultipleSelect {
+		return nil, fmt.Errorf("%s questions cannot be written as qti, only choice questions can", question.Type)
+	}
+
+	item := &qti.Item{
+		Identifier: fmt.Sprintf("item-%d", question.Position),
+		Title:      fmt.Sprintf("Question %d", question.Position),
+		Prompt:     question.Content,
+		Multiple:   question.IsMultipleSelect(),
+		MaxScore:   question.Points,
+	}
+
+	for _, choice := range question.Choices {
+		item.Choices = append(item.Choices, &qti.Choice{
+			Identifier: fmt.Sprintf("choice-%d", choice.Position),
+			Text:       choice.Content,
+			Correct:    choice.IsCorrectAnswer,
+		})
+	}
+
+	return item, nil
+}
+
+// fromQTIItem describes a choice item the same way a question is received in AddQuestions
+func fromQTIItem(item *qti.Item) (*AddQuestion, error) {
+	if item.Prompt == "" {
+		return nil, errors.New("item has no question text")
+	}
+
+	if item.MaxScore < 0 || item.MaxScore > 100 {
+		return nil, errors.New("item MAXSCORE must be between 0 and 100")
+	}
+
+	questionCmd := &AddQuestion{
+		Type:          constant.SingleChoice,
+		Content:       item.Prompt,
+		ContentFormat: constant.PlainText,
+		Points:        item.MaxScore,
+	}
+
+	if item.Multiple {
+		questionCmd.Type = constant.MultipleSelect
+	}
+
+	for _, choice := range item.Choices {
+		if choice.Text == "" {
+			return nil, fmt.Errorf("choice %s has no text", choice.Identifier)
+		}
+
+		questionCmd.Choices = append(questionCmd.Choices, &AddQuestionChoice{
+			Content:         choice.Text,
+			IsCorrectAnswer: choice.Correct,
+		})
+	}
+
+	return questionCmd, nil
+}
@@ -0,0 +1,67 @@
+package service
+
+import (
+	"context"
+
+	"github.com/arvinpaundra/private-api/domain/module/repository"
+	"github.com/arvinpaundra/private-api/domain/shared/interfaces"
+)
+
+type ReorderQuestionsCommand struct {
+	ModuleSlug  string   `json:"-" validate:"required"`
+	QuestionIDs []string `json:"question_ids" validate:"required,min=1,unique,dive,required"`
+}
+
+type ReorderQuestions struct {
+	authStorage  interfaces.AuthenticatedUser
+	moduleReader repository.ModuleReader
+	uow          repository.UnitOfWork
+}
+
+func NewReorderQuestions(
+	authStorage interfaces.AuthenticatedUser,
+	moduleReader repository.ModuleReader,
+	uow repository.UnitOfWork,
+) *ReorderQuestions {
+	return &ReorderQuestions{
+		authStorage:  authStorage,
+		moduleReader: moduleReader,
+		uow:          uow,
+	}
+}
+
+func (s *ReorderQuestions) Execute(ctx context.Context, command *ReorderQuestionsCommand) error {
+	// Load module with its questions, scoped to the user
+	module, err := s.moduleReader.FindModuleDetailBySlug(ctx, command.ModuleSlug, s.authStorage.GetUserId())
+	if err != nil {
+		return err
+	}
+
+	// Apply the new order, every question must be listed once
+	err = module.ReorderQuestions(command.QuestionIDs)
+	if err != nil {
+		return err
+	}
+
+	// Begin transaction
+	tx, err := s.uow.Begin()
+	if err != nil {
+		return err
+	}
+
+	// Save module (which will cascade to questions)
+	err = tx.ModuleWriter().Save(ctx, module)
+	if err != nil {
+		if uowErr := tx.Rollback(); uowErr != nil {
+			return uowErr
+		}
+		return err
+	}
+
+	// Commit transaction
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	return nil
+}
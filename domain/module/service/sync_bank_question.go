@@ -0,0 +1,90 @@
+package service
+
+import (
+	"context"
+
+	"github.com/arvinpaundra/private-api/domain/module/repository"
+	"github.com/arvinpaundra/private-api/domain/shared/interfaces"
+)
+
+type SyncBankQuestionCommand struct {
+	BankQuestionID string
+	Question       *AddQuestion
+
+	// ValidateOnly checks the edit fits every linked module without saving it
+	ValidateOnly bool
+}
+
+// SyncBankQuestion copies a bank question edit into the unpublished module questions that reference it
+type SyncBankQuestion struct {
+	authStorage  interfaces.AuthenticatedUser
+	moduleReader repository.ModuleReader
+	uow          repository.UnitOfWork
+}
+
+func NewSyncBankQuestion(
+	authStorage interfaces.AuthenticatedUser,
+	moduleReader repository.ModuleReader,
+	uow repository.UnitOfWork,
+) *SyncBankQuestion {
+	return &SyncBankQuestion{
+		authStorage:  authStorage,
+		moduleReader: moduleReader,
+		uow:          uow,
+	}
+}
+
+func (s *SyncBankQuestion) Execute(ctx context.Context, command *SyncBankQuestionCommand) error {
+	// Load unpublished modules with only the questions linked to the bank question
+	modules, err := s.moduleReader.FindUnpublishedModulesLinkedTo(ctx, command.BankQuestionID, s.authStorage.GetUserId())
+	if err != nil {
+		return err
+	}
+
+	if len(modules) == 0 {
+		return nil
+	}
+
+	for _, module := range modules {
+		for _, question := range module.Questions {
+			// Replace question content, type and answers
+			replaceQuestion(question, command.Question, command.Question.Type)
+
+			// Validate answers against the module type
+			err = module.ValidateQuestion(question)
+			if err != nil {
+				return err
+			}
+		}
+
+		module.MarkUpdate()
+	}
+
+	if command.ValidateOnly {
+		return nil
+	}
+
+	// Begin transaction
+	tx, err := s.uow.Begin()
+	if err != nil {
+		return err
+	}
+
+	// Save every module in the same transaction so the edit lands everywhere or nowhere
+	for _, module := range modules {
+		err = tx.ModuleWriter().Save(ctx, module)
+		if err != nil {
+			if uowErr := tx.Rollback(); uowErr != nil {
+				return uowErr
+			}
+			return err
+		}
+	}
+
+	// Commit transaction
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	return nil
+}
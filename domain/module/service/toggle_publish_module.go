@@ -14,24 +14,24 @@ type TogglePublishModuleCommand struct {
 type TogglePublishModule struct {
 	authStorage  interfaces.AuthenticatedUser
 	moduleReader repository.ModuleReader
-	moduleWriter repository.ModuleWriter
+	uow          repository.UnitOfWork
 }
 
 func NewTogglePublishModule(
 	authStorage interfaces.AuthenticatedUser,
 	moduleReader repository.ModuleReader,
-	moduleWriter repository.ModuleWriter,
+	uow repository.UnitOfWork,
 ) *TogglePublishModule {
 	return &TogglePublishModule{
 		authStorage:  authStorage,
 		moduleReader: moduleReader,
-		moduleWriter: moduleWriter,
+		uow:          uow,
 	}
 }
 
 func (s *TogglePublishModule) Execute(ctx context.Context, command *TogglePublishModuleCommand) error {
-	// Find module by slug
-	module, err := s.moduleReader.FindBySlug(ctx, command.Slug, s.authStorage.GetUserId())
+	// Load module with its questions, the first publish freezes them as version 1
+	module, err := s.moduleReader.FindModuleDetailBySlug(ctx, command.Slug, s.authStorage.GetUserId())
 	if err != nil {
 		return err
 	}
@@ -43,8 +43,23 @@ func (s *TogglePublishModule) Execute(ctx context.Context, command *TogglePublis
 		module.Publish()
 	}
 
-	// Save via aggregate root guard
-	if err := s.moduleWriter.Save(ctx, module); err != nil {
+	// Begin transaction
+	tx, err := s.uow.Begin()
+	if err != nil {
+		return err
+	}
+
+	// Save via aggregate root guard, publishing may insert a version too
+	err = tx.ModuleWriter().Save(ctx, module)
+	if err != nil {
+		if uowErr := tx.Rollback(); uowErr != nil {
+			return uowErr
+		}
+		return err
+	}
+
+	// Commit transaction
+	if err := tx.Commit(); err != nil {
 		return err
 	}
 
@@ -0,0 +1,125 @@
+package service
+
+import (
+	"context"
+
+	"github.com/arvinpaundra/private-api/domain/module/constant"
+	"github.com/arvinpaundra/private-api/domain/module/repository"
+	"github.com/arvinpaundra/private-api/domain/shared/interfaces"
+)
+
+type UpdateModuleCommand struct {
+	Slug        string  `json:"-" validate:"required"`
+	Title       string  `json:"title" validate:"required,max=100"`
+	SubjectID   string  `json:"subject_id" validate:"required"`
+	GradeID     string  `json:"grade_id" validate:"required"`
+	Description *string `json:"description,omitempty"`
+
+	// Settings left out of the request keep their current value
+	WrongAnswerPenalty *float64 `json:"wrong_answer_penalty" validate:"omitempty,min=0,max=1"`
+	ShuffleQuestions   *bool    `json:"shuffle_questions"`
+	ShuffleChoices     *bool    `json:"shuffle_choices"`
+	DrawCount          *int     `json:"draw_count" validate:"omitempty,min=0,max=500"`
+	StratifyDraw       *bool    `json:"stratify_draw"`
+	TimeLimitMinutes   *int     `json:"time_limit_minutes" validate:"omitempty,min=0,max=1440"`
+}
+
+type UpdateModule struct {
+	authStorage  interfaces.AuthenticatedUser
+	moduleReader repository.ModuleReader
+	moduleWriter repository.ModuleWriter
+	subjectACL   repository.SubjectACL
+	gradeACL     repository.GradeACL
+}
+
+func NewUpdateModule(
+	authStorage interfaces.AuthenticatedUser,
+	moduleReader repository.ModuleReader,
+	moduleWriter repository.ModuleWriter,
+	subjectACL repository.SubjectACL,
+	gradeACL repository.GradeACL,
+) *UpdateModule {
+	return &UpdateModule{
+		authStorage:  authStorage,
+		moduleReader: moduleReader,
+		moduleWriter: moduleWriter,
+		subjectACL:   subjectACL,
+		gradeACL:     gradeACL,
+	}
+}
+
+func (s *UpdateModule) Execute(ctx context.Context, command *UpdateModuleCommand) error {
+	// Find module by slug
+	module, err := s.moduleReader.FindBySlug(ctx, command.Slug, s.authStorage.GetUserId())
+	if err != nil {
+		return err
+	}
+
+	// check if the new subject exists via ACL
+	if command.SubjectID != module.SubjectID {
+		isSubjectExist, err := s.subjectACL.IsSubjectExist(ctx, command.SubjectID, s.authStorage.GetUserId())
+		if err != nil {
+			return err
+		}
+
+		if !isSubjectExist {
+			return constant.ErrSubjectNotFound
+		}
+	}
+
+	// check if the new grade exists via ACL
+	if command.GradeID != module.GradeID {
+		isGradeExist, err := s.gradeACL.IsGradeExist(ctx, command.GradeID, s.authStorage.GetUserId())
+		if err != nil {
+			return err
+		}
+
+		if !isGradeExist {
+			return constant.ErrGradeNotFound
+		}
+	}
+
+	module.UpdateDetail(command.SubjectID, command.GradeID, command.Title, command.Description)
+
+	// The penalty is frozen with the next published version, answers are graded with the one of their version
+	if command.WrongAnswerPenalty != nil {
+		module.UpdateWrongAnswerPenalty(*command.WrongAnswerPenalty)
+	}
+
+	// Submissions already started keep the order they were given
+	if command.ShuffleQuestions != nil {
+		module.UpdateShuffleQuestions(*command.ShuffleQuestions)
+	}
+
+	// Submissions already started keep the choice order they were given
+	if command.ShuffleChoices != nil {
+		module.UpdateShuffleChoices(*command.ShuffleChoices)
+	}
+
+	// Submissions already started keep the questions they drew
+	if command.DrawCount != nil || command.StratifyDraw != nil {
+		drawCount, stratifyDraw := module.DrawCount, module.StratifyDraw
+
+		if command.DrawCount != nil {
+			drawCount = *command.DrawCount
+		}
+		if command.StratifyDraw != nil {
+			stratifyDraw = *command.StratifyDraw
+		}
+
+		module.UpdateDraw(drawCount, stratifyDraw)
+	}
+
+	// Submissions already started keep the deadline they were given
+	if command.TimeLimitMinutes != nil {
+		module.UpdateTimeLimit(*command.TimeLimitMinutes)
+	}
+
+	// store module to persistent storage
+	err = s.moduleWriter.Save(ctx, module)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/arvinpaundra/private-api/domain/module/repository"
+	"github.com/arvinpaundra/private-api/domain/shared/interfaces"
+)
+
+type UpdateModuleScheduleCommand struct {
+	Slug string `json:"-" validate:"required"`
+
+	// OpensAt and ClosesAt replace the schedule, nil leaves that end open
+	OpensAt  *time.Time `json:"opens_at"`
+	ClosesAt *time.Time `json:"closes_at"`
+}
+
+type UpdateModuleSchedule struct {
+	authStorage  interfaces.AuthenticatedUser
+	moduleReader repository.ModuleReader
+	moduleWriter repository.ModuleWriter
+}
+
+func NewUpdateModuleSchedule(
+	authStorage interfaces.AuthenticatedUser,
+	moduleReader repository.ModuleReader,
+	moduleWriter repository.ModuleWriter,
+) *UpdateModuleSchedule {
+	return &UpdateModuleSchedule{
+		authStorage:  authStorage,
+		moduleReader: moduleReader,
+		moduleWriter: moduleWriter,
+	}
+}
+
+func (s *UpdateModuleSchedule) Execute(ctx context.Context, command *UpdateModuleScheduleCommand) error {
+	// Find module by slug
+	module, err := s.moduleReader.FindBySlug(ctx, command.Slug, s.authStorage.GetUserId())
+	if err != nil {
+		return err
+	}
+
+	// Rescheduling takes effect right away, submissions in progress are finalized once the module closes
+	err = module.UpdateSchedule(command.OpensAt, command.ClosesAt)
+	if err != nil {
+		return err
+	}
+
+	// store module to persistent storage
+	err = s.moduleWriter.Save(ctx, module)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
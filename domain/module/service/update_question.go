@@ -0,0 +1,86 @@
+package service
+
+import (
+	"context"
+
+	"github.com/arvinpaundra/private-api/domain/module/repository"
+	"github.com/arvinpaundra/private-api/domain/shared/interfaces"
+)
+
+type UpdateQuestionCommand struct {
+	ModuleSlug string `json:"-" validate:"required"`
+	QuestionID string `json:"-" validate:"required,uuid"`
+
+	AddQuestion
+}
+
+type UpdateQuestion struct {
+	authStorage  interfaces.AuthenticatedUser
+	moduleReader repository.ModuleReader
+	uow          repository.UnitOfWork
+}
+
+func NewUpdateQuestion(
+	authStorage interfaces.AuthenticatedUser,
+	moduleReader repository.ModuleReader,
+	uow repository.UnitOfWork,
+) *UpdateQuestion {
+	return &UpdateQuestion{
+		authStorage:  authStorage,
+		moduleReader: moduleReader,
+		uow:          uow,
+	}
+}
+
+func (s *UpdateQuestion) Execute(ctx context.Context, command *UpdateQuestionCommand) error {
+	// Load module with its questions, scoped to the user
+	module, err := s.moduleReader.FindModuleDetailBySlug(ctx, command.ModuleSlug, s.authStorage.GetUserId())
+	if err != nil {
+		return err
+	}
+
+	question, err := module.FindQuestion(command.QuestionID)
+	if err != nil {
+		return err
+	}
+
+	// Fall back to the module's default question type
+	questionType := command.Type
+	if questionType == "" {
+		questionType = module.DefaultQuestionType()
+	}
+
+	// Replace question content, type and answers
+	replaceQuestion(question, &command.AddQuestion, questionType)
+	question.UpdateTag(command.Tag)
+
+	// Validate answers against the module type
+	err = module.ValidateQuestion(question)
+	if err != nil {
+		return err
+	}
+
+	module.MarkUpdate()
+
+	// Begin transaction
+	tx, err := s.uow.Begin()
+	if err != nil {
+		return err
+	}
+
+	// Save module (which will cascade to questions)
+	err = tx.ModuleWriter().Save(ctx, module)
+	if err != nil {
+		if uowErr := tx.Rollback(); uowErr != nil {
+			return uowErr
+		}
+		return err
+	}
+
+	// Commit transaction
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,153 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/arvinpaundra/private-api/core/storage"
+	"github.com/arvinpaundra/private-api/core/util"
+	"github.com/arvinpaundra/private-api/domain/module/constant"
+	"github.com/arvinpaundra/private-api/domain/module/entity"
+	"github.com/arvinpaundra/private-api/domain/module/repository"
+	"github.com/arvinpaundra/private-api/domain/module/response"
+	"github.com/arvinpaundra/private-api/domain/shared/interfaces"
+)
+
+type UploadAttachmentCommand struct {
+	ModuleSlug     string    `form:"-" validate:"required"`
+	QuestionID     string    `form:"-" validate:"required,uuid"`
+	ChoicePosition int       `form:"choice_position" validate:"min=0"`
+	Filename       string    `form:"-" validate:"required,max=255"`
+	Size           int64     `form:"-" validate:"min=1"`
+	File           io.Reader `form:"-"`
+}
+
+type UploadAttachment struct {
+	authStorage  interfaces.AuthenticatedUser
+	moduleReader repository.ModuleReader
+	uow          repository.UnitOfWork
+	fileStorage  storage.Storage
+}
+
+func NewUploadAttachment(
+	authStorage interfaces.AuthenticatedUser,
+	moduleReader repository.ModuleReader,
+	uow repository.UnitOfWork,
+	fileStorage storage.Storage,
+) *UploadAttachment {
+	return &UploadAttachment{
+		authStorage:  authStorage,
+		moduleReader: moduleReader,
+		uow:          uow,
+		fileStorage:  fileStorage,
+	}
+}
+
+func (s *UploadAttachment) Execute(ctx context.Context, command *UploadAttachmentCommand) (*response.Attachment, error) {
+	if command.Size > constant.MaxAttachmentSize {
+		return nil, constant.ErrAttachmentTooLarge
+	}
+
+	// Load module with its questions, scoped to the user
+	module, err := s.moduleReader.FindModuleDetailBySlug(ctx, command.ModuleSlug, s.authStorage.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	question, err := module.FindQuestion(command.QuestionID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Sniff the content type from the file itself instead of trusting the client
+	head := make([]byte, 512)
+
+	n, err := io.ReadFull(command.File, head)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+
+	head = head[:n]
+
+	contentType := http.DetectContentType(head)
+
+	extension, allowed := constant.AttachmentTypes[contentType]
+	if !allowed {
+		return nil, constant.ErrUnsupportedAttachmentType
+	}
+
+	key := "attachments/" + module.ID + "/" + util.GenerateUUID() + extension
+
+	attachment := entity.NewQuestionAttachment(question.ID, command.ChoicePosition, key, command.Filename, contentType, command.Size)
+
+	err = question.Attach(attachment)
+	if err != nil {
+		return nil, err
+	}
+
+	module.MarkUpdate()
+
+	err = s.fileStorage.Put(ctx, key, io.MultiReader(bytes.NewReader(head), command.File), command.Size, contentType)
+	if err != nil {
+		return nil, err
+	}
+
+	// Begin transaction
+	tx, err := s.uow.Begin()
+	if err != nil {
+		return nil, err
+	}
+
+	// Save module (which will cascade to questions)
+	err = tx.ModuleWriter().Save(ctx, module)
+	if err != nil {
+		if uowErr := tx.Rollback(); uowErr != nil {
+			return nil, uowErr
+		}
+
+		// Nothing references the file, so it can go
+		_ = s.fileStorage.Delete(ctx, key)
+
+		return nil, err
+	}
+
+	// Commit transaction
+	if err := tx.Commit(); err != nil {
+		_ = s.fileStorage.Delete(ctx, key)
+
+		return nil, err
+	}
+
+	attachments, err := toAttachmentResponses(ctx, s.fileStorage, []*entity.QuestionAttachment{attachment})
+	if err != nil {
+		return nil, err
+	}
+
+	return attachments[0], nil
+}
+
+// toAttachmentResponses describes the attachments with signed, time-limited links to their files
+func toAttachmentResponses(ctx context.Context, fileStorage storage.Storage, attachments []*entity.QuestionAttachment) ([]*response.Attachment, error) {
+	result := make([]*response.Attachment, len(attachments))
+
+	for i, attachment := range attachments {
+		url, err := fileStorage.SignedURL(ctx, attachment.StorageKey, constant.AttachmentURLExpiry)
+		if err != nil {
+			return nil, err
+		}
+
+		result[i] = &response.Attachment{
+			ID:             attachment.ID,
+			ChoicePosition: attachment.ChoicePosition,
+			Filename:       attachment.Filename,
+			ContentType:    attachment.ContentType,
+			Size:           attachment.Size,
+			URL:            url,
+		}
+	}
+
+	return result, nil
+}
@@ -31,14 +31,24 @@ func (s *ValidatePublishedModule) Execute(ctx context.Context, command *Validate
 	}
 
 	return &response.Module{
-		ID:          module.ID,
-		UserID:      module.UserID,
-		SubjectID:   module.SubjectID,
-		GradeID:     module.GradeID,
-		Title:       module.Title,
-		Description: module.Description,
-		Type:        module.Type,
-		IsPublished: module.IsPublished,
-		Slug:        module.Slug,
+		ID:                 module.ID,
+		UserID:             module.UserID,
+		SubjectID:          module.SubjectID,
+		GradeID:            module.GradeID,
+		Title:              module.Title,
+		Description:        module.Description,
+		Type:               module.Type,
+		ScoringPolicy:      module.ScoringPolicy,
+		WrongAnswerPenalty: module.WrongAnswerPenalty,
+		ShuffleQuestions:   module.ShuffleQuestions,
+		ShuffleChoices:     module.ShuffleChoices,
+		DrawCount:          module.DrawCount,
+		StratifyDraw:       module.StratifyDraw,
+		TimeLimitMinutes:   module.TimeLimitMinutes,
+		OpensAt:            module.OpensAt,
+		ClosesAt:           module.ClosesAt,
+		IsPublished:        module.IsPublished,
+		PublishedVersion:   module.PublishedVersion,
+		Slug:               module.Slug,
 	}, nil
 }
@@ -0,0 +1,29 @@
+package service
+
+import (
+	"context"
+
+	"github.com/arvinpaundra/private-api/domain/module/entity"
+)
+
+type ValidateQuestionCommand struct {
+	Question *AddQuestion
+}
+
+// ValidateQuestion checks a question outside of any module, such as one kept in the question bank
+type ValidateQuestion struct{}
+
+func NewValidateQuestion() *ValidateQuestion {
+	return &ValidateQuestion{}
+}
+
+func (s *ValidateQuestion) Execute(ctx context.Context, command *ValidateQuestionCommand) error {
+	question, err := entity.NewQuestion("", command.Question.Content, command.Question.ContentFormat, command.Question.Type)
+	if err != nil {
+		return err
+	}
+
+	addAnswers(question, command.Question)
+
+	return question.Validate()
+}
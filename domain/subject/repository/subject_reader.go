@@ -11,6 +11,7 @@ type SubjectReader interface {
 	HasSimilarSubjectExclusive(ctx context.Context, name string, userID string, excludeSubjectID string) (bool, error)
 	IsSubjectExist(ctx context.Context, subjectID string, userID string) (bool, error)
 	FindSubjectByID(ctx context.Context, subjectID string, userID string) (*entity.Subject, error)
+	FindSubjectByName(ctx context.Context, name string, userID string) (*entity.Subject, error)
 	CountByUserID(ctx context.Context, userID string) (int, error)
 	AllSubjects(ctx context.Context, userID string, keyword string) ([]*entity.Subject, error)
 }
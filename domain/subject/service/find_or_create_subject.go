@@ -0,0 +1,55 @@
+package service
+
+import (
+	"context"
+
+	"github.com/arvinpaundra/private-api/domain/shared/interfaces"
+	"github.com/arvinpaundra/private-api/domain/subject/constant"
+	"github.com/arvinpaundra/private-api/domain/subject/entity"
+	"github.com/arvinpaundra/private-api/domain/subject/repository"
+)
+
+type FindOrCreateSubjectCommand struct {
+	Name string `validate:"required,max=100"`
+}
+
+type FindOrCreateSubject struct {
+	authStorage   interfaces.AuthenticatedUser
+	subjectReader repository.SubjectReader
+	subjectWriter repository.SubjectWriter
+}
+
+func NewFindOrCreateSubject(
+	authStorage interfaces.AuthenticatedUser,
+	subjectReader repository.SubjectReader,
+	subjectWriter repository.SubjectWriter,
+) *FindOrCreateSubject {
+	return &FindOrCreateSubject{
+		authStorage:   authStorage,
+		subjectReader: subjectReader,
+		subjectWriter: subjectWriter,
+	}
+}
+
+// Execute returns the ID of the user's subject with the given name, creating the subject when there is none
+func (s *FindOrCreateSubject) Execute(ctx context.Context, command *FindOrCreateSubjectCommand) (string, error) {
+	subject, err := s.subjectReader.FindSubjectByName(ctx, command.Name, s.authStorage.GetUserId())
+	if err == nil {
+		return subject.ID, nil
+	}
+
+	if err != constant.ErrSubjectNotFound {
+		return "", err
+	}
+
+	// Create subject
+	subject = entity.NewSubject(s.authStorage.GetUserId(), command.Name, nil)
+
+	// Store subject to persistent storage
+	err = s.subjectWriter.Save(ctx, subject)
+	if err != nil {
+		return "", err
+	}
+
+	return subject.ID, nil
+}
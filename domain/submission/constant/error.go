@@ -10,10 +10,19 @@ var (
 	ErrCannotSubmit          = errors.New("cannot submit submission in current state")
 	ErrCannotCancel          = errors.New("cannot cancel submission in current state")
 	ErrSubmissionAlreadyDone = errors.New("submission already submitted or canceled")
+	ErrSubmissionNotDone     = errors.New("submission must be finalized before it can be reviewed")
+	ErrSubmissionExpired     = errors.New("submission time is up, it was finalized on the answers given")
 	ErrDuplicateAnswer       = errors.New("answer for this question already submitted")
+	ErrInvalidAnswerFormat   = errors.New("answer does not match the question format")
+	ErrIncompletePairs       = errors.New("every left item must be paired exactly once")
+	ErrInvalidNumber         = errors.New("answer is not a valid number")
+	ErrIncompleteOrder       = errors.New("every item must be placed exactly once")
 
 	// Context mapping errors - submission's perspective on related entities
 	ErrModuleNotFound   = errors.New("module not found")
+	ErrModuleNotOpen    = errors.New("module is not open yet")
+	ErrModuleClosed     = errors.New("module is closed")
 	ErrQuestionNotFound = errors.New("question not found")
 	ErrChoiceNotFound   = errors.New("choice not found")
+	ErrPairNotFound     = errors.New("pair item not found")
 )
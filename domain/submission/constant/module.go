@@ -0,0 +1,34 @@
+package constant
+
+type ModuleType string
+
+const (
+	MultipleChoice ModuleType = "multiple_choice"
+	MatchingType   ModuleType = "matching_type"
+)
+
+type QuestionType string
+
+const (
+	SingleChoice   QuestionType = "single_choice"
+	MultipleSelect QuestionType = "multiple_select"
+	Matching       QuestionType = "matching"
+	ShortAnswer    QuestionType = "short_answer"
+	Numeric        QuestionType = "numeric"
+	Ordering       QuestionType = "ordering"
+)
+
+type ToleranceType string
+
+const (
+	AbsoluteTolerance   ToleranceType = "absolute"
+	PercentageTolerance ToleranceType = "percentage"
+)
+
+type ScoringPolicy string
+
+const (
+	AllOrNothing    ScoringPolicy = "all_or_nothing"
+	Proportional    ScoringPolicy = "proportional"
+	RightMinusWrong ScoringPolicy = "right_minus_wrong"
+)
@@ -10,4 +10,5 @@ const (
 	InProgress SubmissionStatus = "inprogress"
 	Submitted  SubmissionStatus = "submitted"
 	Canceled   SubmissionStatus = "canceled"
+	Expired    SubmissionStatus = "expired"
 )
@@ -0,0 +1,119 @@
+package entity
+
+import (
+	"math"
+	"regexp"
+	"strings"
+
+	"github.com/arvinpaundra/private-api/core/util"
+	"github.com/arvinpaundra/private-api/domain/submission/constant"
+)
+
+// AnswerKey is everything needed to grade a question; only the part matching its type is set
+type AnswerKey struct {
+	Type               constant.QuestionType
+	Points             float64
+	WrongAnswerPenalty float64 // fraction of the points lost on a completely wrong answer, as of the version graded on
+	Rationale          string
+	Choices            []*Choice
+	Pairs              []*Pair
+	Text               *TextAnswerKey
+	Numeric            *NumericAnswerKey
+}
+
+// TextAnswerKey holds the accepted answers of a short answer question and how typed text is compared to them
+type TextAnswerKey struct {
+	CaseSensitive       bool
+	DiacriticsSensitive bool
+	AcceptedAnswers     []*AcceptedAnswer
+}
+
+type AcceptedAnswer struct {
+	Content string
+	IsRegex bool
+}
+
+// Matches reports whether the typed text is one of the accepted answers.
+// Whitespace is always trimmed and collapsed before comparing.
+func (k *TextAnswerKey) Matches(text string) bool {
+	normalized := k.normalize(text)
+
+	for _, accepted := range k.AcceptedAnswers {
+		if accepted.IsRegex {
+			// Patterns keep their own casing and spacing so escapes like \D stay intact
+			pattern := accepted.Content
+			if !k.DiacriticsSensitive {
+				pattern = util.RemoveDiacritics(pattern)
+			}
+
+			pattern = "^(?:" + pattern + ")$"
+			if !k.CaseSensitive {
+				pattern = "(?i)" + pattern
+			}
+
+			re, err := regexp.Compile(pattern)
+			if err != nil {
+				continue
+			}
+
+			if re.MatchString(normalized) {
+				return true
+			}
+
+			continue
+		}
+
+		if normalized == k.normalize(accepted.Content) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (k *TextAnswerKey) normalize(text string) string {
+	text = util.CollapseSpaces(text)
+
+	if !k.CaseSensitive {
+		text = strings.ToLower(text)
+	}
+
+	if !k.DiacriticsSensitive {
+		text = util.RemoveDiacritics(text)
+	}
+
+	return text
+}
+
+// NumericAnswerKey is the expected value of a numeric question with its accepted margin and units
+type NumericAnswerKey struct {
+	Value         float64
+	Tolerance     float64
+	ToleranceType constant.ToleranceType
+	Units         []string
+}
+
+// Matches reports whether the value lies within the tolerance and, when units are listed, the unit is one of them
+func (k *NumericAnswerKey) Matches(value float64, unit string) bool {
+	if len(k.Units) > 0 && !k.HasUnit(unit) {
+		return false
+	}
+
+	margin := k.Tolerance
+	if k.ToleranceType == constant.PercentageTolerance {
+		margin = math.Abs(k.Value) * k.Tolerance / 100
+	}
+
+	// Small epsilon so values such as 0.1 + 0.2 are not rejected by float rounding
+	return math.Abs(value-k.Value) <= margin+1e-9
+}
+
+func (k *NumericAnswerKey) HasUnit(unit string) bool {
+	for _, accepted := range k.Units {
+		if strings.EqualFold(strings.TrimSpace(accepted), strings.TrimSpace(unit)) {
+			return true
+		}
+	}
+
+	return false
+}
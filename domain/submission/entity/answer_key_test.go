@@ -0,0 +1,77 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/arvinpaundra/private-api/core/util"
+	"github.com/arvinpaundra/private-api/domain/submission/constant"
+)
+
+func TestTextAnswerKeyMatches(t *testing.T) {
+	tests := []struct {
+		name string
+		key  *TextAnswerKey
+		text string
+		want bool
+	}{
+		{"exact", &TextAnswerKey{AcceptedAnswers: []*AcceptedAnswer{{Content: "Paris"}}}, "Paris", true},
+		{"case ignored", &TextAnswerKey{AcceptedAnswers: []*AcceptedAnswer{{Content: "Paris"}}}, "pARIS", true},
+		{"case sensitive", &TextAnswerKey{CaseSensitive: true, AcceptedAnswers: []*AcceptedAnswer{{Content: "Paris"}}}, "paris", false},
+		{"spaces collapsed", &TextAnswerKey{AcceptedAnswers: []*AcceptedAnswer{{Content: "New York"}}}, "  new   york ", true},
+		{"diacritics ignored", &TextAnswerKey{AcceptedAnswers: []*AcceptedAnswer{{Content: "Bogotá"}}}, "bogota", true},
+		{"diacritics ignored in the answer", &TextAnswerKey{AcceptedAnswers: []*AcceptedAnswer{{Content: "Bogota"}}}, "Bogotá", true},
+		{"diacritics sensitive", &TextAnswerKey{DiacriticsSensitive: true, AcceptedAnswers: []*AcceptedAnswer{{Content: "Bogotá"}}}, "Bogota", false},
+		{"second accepted answer", &TextAnswerKey{AcceptedAnswers: []*AcceptedAnswer{{Content: "UK"}, {Content: "United Kingdom"}}}, "united kingdom", true},
+		{"not accepted", &TextAnswerKey{AcceptedAnswers: []*AcceptedAnswer{{Content: "Paris"}}}, "Lyon", false},
+		{"regex", &TextAnswerKey{AcceptedAnswers: []*AcceptedAnswer{{Content: `colou?r`, IsRegex: true}}}, "Color", true},
+		{"regex matches the whole answer", &TextAnswerKey{AcceptedAnswers: []*AcceptedAnswer{{Content: `colou?r`, IsRegex: true}}}, "colors", false},
+		{"regex alternation anchored", &TextAnswerKey{AcceptedAnswers: []*AcceptedAnswer{{Content: `cat|dog`, IsRegex: true}}}, "hotdog", false},
+		{"regex keeps its escapes", &TextAnswerKey{AcceptedAnswers: []*AcceptedAnswer{{Content: `\d+ apples`, IsRegex: true}}}, "12 Apples", true},
+		{"regex case sensitive", &TextAnswerKey{CaseSensitive: true, AcceptedAnswers: []*AcceptedAnswer{{Content: `colou?r`, IsRegex: true}}}, "Color", false},
+		{"regex diacritics ignored", &TextAnswerKey{AcceptedAnswers: []*AcceptedAnswer{{Content: `caf[eé]s?`, IsRegex: true}}}, "cafés", true},
+		{"invalid regex skipped", &TextAnswerKey{AcceptedAnswers: []*AcceptedAnswer{{Content: `(`, IsRegex: true}, {Content: "("}}}, "(", true},
+	}
+
+	for _, tt := range tests {
+		if got := tt.key.Matches(tt.text); got != tt.want {
+			t.Errorf("%s: Matches(%q) = %v, want %v", tt.name, tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestNumericAnswerKeyMatches(t *testing.T) {
+	tests := []struct {
+		name string
+		key  *NumericAnswerKey
+		text string
+		unit string
+		want bool
+	}{
+		{"exact", &NumericAnswerKey{Value: 3.5}, "3.5", "", true},
+		{"comma decimal", &NumericAnswerKey{Value: 3.5}, "3,5", "", true},
+		{"float rounding", &NumericAnswerKey{Value: 0.3}, "0.30000000000000004", "", true},
+		{"off without tolerance", &NumericAnswerKey{Value: 3.5}, "3.51", "", false},
+		{"within absolute tolerance", &NumericAnswerKey{Value: 10, Tolerance: 0.5, ToleranceType: constant.AbsoluteTolerance}, "10,5", "", true},
+		{"outside absolute tolerance", &NumericAnswerKey{Value: 10, Tolerance: 0.5, ToleranceType: constant.AbsoluteTolerance}, "10.6", "", false},
+		{"within percentage tolerance", &NumericAnswerKey{Value: 200, Tolerance: 5, ToleranceType: constant.PercentageTolerance}, "190", "", true},
+		{"outside percentage tolerance", &NumericAnswerKey{Value: 200, Tolerance: 5, ToleranceType: constant.PercentageTolerance}, "189", "", false},
+		{"percentage of a negative value", &NumericAnswerKey{Value: -200, Tolerance: 5, ToleranceType: constant.PercentageTolerance}, "-210", "", true},
+		{"same tolerance read as absolute", &NumericAnswerKey{Value: 200, Tolerance: 5, ToleranceType: constant.AbsoluteTolerance}, "190", "", false},
+		{"unit accepted", &NumericAnswerKey{Value: 9.8, Units: []string{"m/s2", "N/kg"}}, "9,8", " n/KG ", true},
+		{"unit missing", &NumericAnswerKey{Value: 9.8, Units: []string{"m/s2"}}, "9.8", "", false},
+		{"unit not accepted", &NumericAnswerKey{Value: 9.8, Units: []string{"m/s2"}}, "9.8", "km/h", false},
+		{"unit ignored without units", &NumericAnswerKey{Value: 9.8}, "9.8", "m/s2", true},
+	}
+
+	for _, tt := range tests {
+		// Typed numbers reach the key the way submitting parses them
+		value, err := util.ParseDecimal(tt.text)
+		if err != nil {
+			t.Fatalf("%s: ParseDecimal(%q) returned error: %v", tt.name, tt.text, err)
+		}
+
+		if got := tt.key.Matches(value, tt.unit); got != tt.want {
+			t.Errorf("%s: Matches(%q, %q) = %v, want %v", tt.name, tt.text, tt.unit, got, tt.want)
+		}
+	}
+}
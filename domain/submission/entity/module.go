@@ -1,11 +1,48 @@
 package entity
 
+import (
+	"time"
+
+	"github.com/arvinpaundra/private-api/domain/submission/constant"
+)
+
 type Module struct {
-	ID      string
-	Slug    string
-	Title   string
-	Grade   *Grade
-	Subject *Subject
+	ID               string
+	Slug             string
+	Title            string
+	Type             constant.ModuleType
+	ScoringPolicy    constant.ScoringPolicy
+	ShuffleQuestions bool // every submission is served the questions in its own order
+	ShuffleChoices   bool // every submission is served the choices of each question in its own order
+	Version          int  // published version new submissions start on
+	IsPreview        bool // the draft, served to its teacher to try the module out
+	TimeLimitMinutes int  // how long every submission runs before it expires, 0 leaves it untimed
+	OpensAt          *time.Time
+	ClosesAt         *time.Time
+	Grade            *Grade
+	Subject          *Subject
+}
+
+func (m *Module) IsMatchingType() bool {
+	return m.Type == constant.MatchingType
+}
+
+// CheckOpen tells whether a submission can be started on the module at the given time
+func (m *Module) CheckOpen(now time.Time) error {
+	if m.OpensAt != nil && now.Before(*m.OpensAt) {
+		return constant.ErrModuleNotOpen
+	}
+
+	if m.IsClosed(now) {
+		return constant.ErrModuleClosed
+	}
+
+	return nil
+}
+
+// IsClosed reports whether the module closed at or before the given time
+func (m *Module) IsClosed(now time.Time) bool {
+	return m.ClosesAt != nil && !now.Before(*m.ClosesAt)
 }
 
 type Grade struct {
@@ -17,3 +54,68 @@ type Subject struct {
 	ID   string
 	Name string
 }
+
+// Credit returns the fraction of a question earned when right out of total items
+// were answered correctly, according to the module scoring policy
+func (m *Module) Credit(right, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+
+	switch m.ScoringPolicy {
+	case constant.Proportional:
+		return float64(right) / float64(total)
+	case constant.RightMinusWrong:
+		wrong := total - right
+		return max(0, float64(right-wrong)/float64(total))
+	default:
+		if right == total {
+			return 1
+		}
+		return 0
+	}
+}
+
+// SelectionCredit returns the fraction of a multiple select question earned when right of its
+// correct choices and wrong of its other choices were ticked, according to the module scoring policy.
+// Choices correctly left unticked earn nothing, only the correct choices count towards the credit.
+func (m *Module) SelectionCredit(right, wrong, correct int) float64 {
+	if correct == 0 {
+		return 0
+	}
+
+	switch m.ScoringPolicy {
+	case constant.Proportional:
+		return float64(right) / float64(correct)
+	case constant.RightMinusWrong:
+		return max(0, float64(right-wrong)/float64(correct))
+	default:
+		if right == correct && wrong == 0 {
+			return 1
+		}
+		return 0
+	}
+}
+
+// OrderCredit returns the fraction of an ordering question earned given the answer-key positions of
+// the items in the order they were placed, according to the module scoring policy. Kendall tau style:
+// every pair of items placed in the same relative order as the key counts as right, so only the exact
+// order is worth everything. A single item cannot be out of order.
+func (m *Module) OrderCredit(positions []int) float64 {
+	if len(positions) == 1 {
+		return 1
+	}
+
+	concordant := 0
+	totalPairs := len(positions) * (len(positions) - 1) / 2
+
+	for i := 0; i < len(positions); i++ {
+		for j := i + 1; j < len(positions); j++ {
+			if positions[i] < positions[j] {
+				concordant++
+			}
+		}
+	}
+
+	return m.Credit(concordant, totalPairs)
+}
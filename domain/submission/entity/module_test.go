@@ -0,0 +1,110 @@
+package entity
+
+import (
+	"math"
+	"testing"
+
+	"github.com/arvinpaundra/private-api/domain/submission/constant"
+)
+
+func TestModuleCredit(t *testing.T) {
+	tests := []struct {
+		policy constant.ScoringPolicy
+		right  int
+		total  int
+		want   float64
+	}{
+		{constant.AllOrNothing, 4, 4, 1},
+		{constant.AllOrNothing, 3, 4, 0},
+		{constant.AllOrNothing, 0, 4, 0},
+		{constant.AllOrNothing, 0, 0, 0},
+		{constant.Proportional, 4, 4, 1},
+		{constant.Proportional, 3, 4, 0.75},
+		{constant.Proportional, 0, 4, 0},
+		{constant.Proportional, 0, 0, 0},
+		{constant.RightMinusWrong, 4, 4, 1},
+		{constant.RightMinusWrong, 3, 4, 0.5},
+		{constant.RightMinusWrong, 2, 4, 0},
+		{constant.RightMinusWrong, 1, 4, 0},
+		{constant.RightMinusWrong, 0, 4, 0},
+		{constant.RightMinusWrong, 0, 0, 0},
+	}
+
+	for _, tt := range tests {
+		module := &Module{ScoringPolicy: tt.policy}
+
+		if got := module.Credit(tt.right, tt.total); got != tt.want {
+			t.Errorf("Credit(%d, %d) with %s = %v, want %v", tt.right, tt.total, tt.policy, got, tt.want)
+		}
+	}
+}
+
+func TestModuleSelectionCredit(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  constant.ScoringPolicy
+		right   int
+		wrong   int
+		correct int
+		want    float64
+	}{
+		{"all right", constant.AllOrNothing, 2, 0, 2, 1},
+		{"all right and one wrong", constant.AllOrNothing, 2, 1, 2, 0},
+		{"one right", constant.AllOrNothing, 1, 0, 2, 0},
+		{"all wrong", constant.AllOrNothing, 0, 2, 2, 0},
+		{"nothing selected", constant.AllOrNothing, 0, 0, 2, 0},
+		{"all right", constant.Proportional, 2, 0, 2, 1},
+		{"all right and one wrong", constant.Proportional, 2, 1, 2, 1},
+		{"one right", constant.Proportional, 1, 0, 2, 0.5},
+		{"all wrong", constant.Proportional, 0, 2, 2, 0},
+		{"nothing selected", constant.Proportional, 0, 0, 2, 0},
+		{"all right", constant.RightMinusWrong, 2, 0, 2, 1},
+		{"all right and one wrong", constant.RightMinusWrong, 2, 1, 2, 0.5},
+		{"one right", constant.RightMinusWrong, 1, 0, 2, 0.5},
+		{"one right and one wrong", constant.RightMinusWrong, 1, 1, 2, 0},
+		{"all wrong", constant.RightMinusWrong, 0, 2, 2, 0},
+		{"nothing selected", constant.RightMinusWrong, 0, 0, 2, 0},
+		{"no correct choices", constant.Proportional, 0, 1, 0, 0},
+	}
+
+	for _, tt := range tests {
+		module := &Module{ScoringPolicy: tt.policy}
+
+		if got := module.SelectionCredit(tt.right, tt.wrong, tt.correct); got != tt.want {
+			t.Errorf("%s: SelectionCredit(%d, %d, %d) with %s = %v, want %v", tt.name, tt.right, tt.wrong, tt.correct, tt.policy, got, tt.want)
+		}
+	}
+}
+
+func TestModuleOrderCredit(t *testing.T) {
+	tests := []struct {
+		name      string
+		policy    constant.ScoringPolicy
+		positions []int
+		want      float64
+	}{
+		{"identical order", constant.AllOrNothing, []int{1, 2, 3, 4}, 1},
+		{"identical order", constant.Proportional, []int{1, 2, 3, 4}, 1},
+		{"identical order", constant.RightMinusWrong, []int{1, 2, 3, 4}, 1},
+		{"fully reversed", constant.AllOrNothing, []int{4, 3, 2, 1}, 0},
+		{"fully reversed", constant.Proportional, []int{4, 3, 2, 1}, 0},
+		{"fully reversed", constant.RightMinusWrong, []int{4, 3, 2, 1}, 0},
+		{"one adjacent swap", constant.AllOrNothing, []int{1, 3, 2, 4}, 0},
+		{"one adjacent swap", constant.Proportional, []int{1, 3, 2, 4}, 5.0 / 6},
+		{"one adjacent swap", constant.RightMinusWrong, []int{1, 3, 2, 4}, 4.0 / 6},
+		{"first and last swapped", constant.Proportional, []int{4, 2, 3, 1}, 1.0 / 6},
+		{"single item", constant.AllOrNothing, []int{1}, 1},
+		{"single item", constant.RightMinusWrong, []int{1}, 1},
+		{"two items in order", constant.Proportional, []int{1, 2}, 1},
+		{"two items swapped", constant.Proportional, []int{2, 1}, 0},
+		{"two items swapped", constant.RightMinusWrong, []int{2, 1}, 0},
+	}
+
+	for _, tt := range tests {
+		module := &Module{ScoringPolicy: tt.policy}
+
+		if got := module.OrderCredit(tt.positions); math.Abs(got-tt.want) > 1e-9 {
+			t.Errorf("%s: OrderCredit(%v) with %s = %v, want %v", tt.name, tt.positions, tt.policy, got, tt.want)
+		}
+	}
+}
@@ -1,11 +1,36 @@
 package entity
 
+import "github.com/arvinpaundra/private-api/domain/submission/constant"
+
 type Question struct {
-	ID               string
-	Content          string
-	Slug             string
-	NextQuestionSlug *string
-	Choices          []*Choice
+	ID         string
+	Type       constant.QuestionType
+	Content    string
+	Slug       string
+	Choices    []*Choice
+	LeftItems  []*MatchingItem
+	RightItems []*MatchingItem
+	Units      []string
+}
+
+func (q *Question) IsMultipleSelect() bool {
+	return q.Type == constant.MultipleSelect
+}
+
+func (q *Question) IsMatching() bool {
+	return q.Type == constant.Matching
+}
+
+func (q *Question) IsShortAnswer() bool {
+	return q.Type == constant.ShortAnswer
+}
+
+func (q *Question) IsOrdering() bool {
+	return q.Type == constant.Ordering
+}
+
+func (q *Question) IsNumeric() bool {
+	return q.Type == constant.Numeric
 }
 
 func (q *Question) GetChoiceByID(choiceID string) (*Choice, bool) {
@@ -17,8 +42,41 @@ func (q *Question) GetChoiceByID(choiceID string) (*Choice, bool) {
 	return nil, false
 }
 
+func (q *Question) GetLeftItemByID(itemID string) (*MatchingItem, bool) {
+	for _, item := range q.LeftItems {
+		if item.ID == itemID {
+			return item, true
+		}
+	}
+	return nil, false
+}
+
+func (q *Question) GetRightItemByID(itemID string) (*MatchingItem, bool) {
+	for _, item := range q.RightItems {
+		if item.ID == itemID {
+			return item, true
+		}
+	}
+	return nil, false
+}
+
 type Choice struct {
 	ID              string
 	Content         string
 	IsCorrectAnswer bool
+	CorrectPosition int
+	Explanation     string // why the choice is right or wrong, only known from the answer key
+}
+
+type MatchingItem struct {
+	ID      string
+	Content string
+}
+
+// Pair is the correct match of a left item, as defined by the module
+type Pair struct {
+	ID           string
+	LeftContent  string
+	RightID      string
+	RightContent string
 }
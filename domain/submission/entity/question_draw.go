@@ -0,0 +1,8 @@
+package entity
+
+// QuestionDraw is what a new submission is served, QuestionIDs is nil when it is served every question
+type QuestionDraw struct {
+	QuestionIDs    []string
+	TotalQuestions int
+	TotalPoints    float64
+}
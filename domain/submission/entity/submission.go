@@ -1,6 +1,7 @@
 package entity
 
 import (
+	"math"
 	"time"
 
 	"github.com/arvinpaundra/private-api/core/trait"
@@ -19,23 +20,31 @@ type Submission struct {
 	StudentName    string
 	Status         constant.SubmissionStatus
 	TotalQuestions int
+	MaxPoints      float64
+	ModuleVersion  int        // module version the submission is served and graded from
+	QuestionSeed   int64      // orders the questions the submission is served, 0 keeps the module order
+	ShuffleChoices bool       // serves the choices in the submission's own order, frozen when it starts
+	QuestionIDs    []string   // questions the submission drew, nil when it is served every question
+	IsPreview      bool       // started by the teacher to try the module out, kept out of listings and counts
+	ExpiresAt      *time.Time // deadline for answering, nil when the module is untimed
 	SubmittedAt    *time.Time
 
 	Answers []*SubmissionAnswer
 }
 
-func NewSubmission(moduleID, studentName string) (*Submission, error) {
+func NewSubmission(moduleID string, moduleVersion int, studentName string) (*Submission, error) {
 	code, err := util.RandomAlphanumeric(16)
 	if err != nil {
 		return nil, err
 	}
 
 	submission := &Submission{
-		ID:          util.GenerateUUID(),
-		ModuleID:    moduleID,
-		Code:        code,
-		StudentName: studentName,
-		Status:      constant.InProgress,
+		ID:            util.GenerateUUID(),
+		ModuleID:      moduleID,
+		ModuleVersion: moduleVersion,
+		Code:          code,
+		StudentName:   studentName,
+		Status:        constant.InProgress,
 	}
 
 	submission.MarkCreate()
@@ -88,6 +97,89 @@ func (s *Submission) IsCanceled() bool {
 	return s.Status == constant.Canceled
 }
 
+func (s *Submission) IsExpired() bool {
+	return s.Status == constant.Expired
+}
+
+// IsFinalized reports whether the submission was scored, finalized by the student or when its time ran out
+func (s *Submission) IsFinalized() bool {
+	return s.IsSubmitted() || s.IsExpired()
+}
+
+// StartTimer gives the submission its deadline, none when the module is untimed
+func (s *Submission) StartTimer(minutes int) {
+	if minutes <= 0 {
+		return
+	}
+
+	expiresAt := time.Now().UTC().Add(time.Duration(minutes) * time.Minute)
+
+	s.ExpiresAt = &expiresAt
+}
+
+// HasExpired reports whether the submission deadline has passed at the given time
+func (s *Submission) HasExpired(now time.Time) bool {
+	return s.ExpiresAt != nil && !now.Before(*s.ExpiresAt)
+}
+
+// RemainingSeconds is the time left to answer at the given time, nil when the submission is untimed
+func (s *Submission) RemainingSeconds(now time.Time) *int {
+	if s.ExpiresAt == nil {
+		return nil
+	}
+
+	remaining := max(0, int(math.Ceil(s.ExpiresAt.Sub(now).Seconds())))
+
+	return &remaining
+}
+
+// Expire finalizes the submission on the answers given before its deadline
+func (s *Submission) Expire() error {
+	if !s.IsInProgress() {
+		return constant.ErrCannotSubmit
+	}
+
+	s.Status = constant.Expired
+	s.SubmittedAt = s.ExpiresAt
+	s.MarkUpdate()
+
+	return nil
+}
+
+// MarkPreview flags the submission as its teacher trying the module out
+func (s *Submission) MarkPreview() {
+	s.IsPreview = true
+}
+
+// BelongsTo reports whether the submission was started on the module. A preview only belongs
+// to the draft it previews and a student submission only to the published module.
+func (s *Submission) BelongsTo(module *Module) bool {
+	return s.ModuleID == module.ID && s.IsPreview == module.IsPreview
+}
+
+// ShuffleQuestions gives the submission its own question order, kept for as long as it runs
+func (s *Submission) ShuffleQuestions() error {
+	seed, err := util.RandomSeed()
+	if err != nil {
+		return err
+	}
+
+	s.QuestionSeed = seed
+
+	return nil
+}
+
+// NextQuestionSlug returns the first question of the served order not answered yet, nil once all are
+func (s *Submission) NextQuestionSlug(order []string) *string {
+	for _, questionSlug := range order {
+		if !s.HasAnsweredQuestion(questionSlug) {
+			return &questionSlug
+		}
+	}
+
+	return nil
+}
+
 func (s *Submission) HasAnsweredQuestion(questionSlug string) bool {
 	for _, answer := range s.Answers {
 		if answer.QuestionSlug == questionSlug {
@@ -97,30 +189,63 @@ func (s *Submission) HasAnsweredQuestion(questionSlug string) bool {
 	return false
 }
 
-func (s *Submission) Finalize() error {
+// Finalize submits the submission as of the given time, the closing time when the module closed on it
+func (s *Submission) Finalize(submittedAt time.Time) error {
 	if err := s.Submit(); err != nil {
 		return err
 	}
 
-	submittedAt := time.Now().UTC()
+	submittedAt = submittedAt.UTC()
 
 	s.SubmittedAt = &submittedAt
 
 	return nil
 }
 
-func (s *Submission) Score() int {
-	score := 0
+func (s *Submission) Score() float64 {
+	score := 0.0
 
 	for _, answer := range s.Answers {
-		if answer.IsCorrect {
-			score++
-		}
+		score += answer.Points
 	}
 
 	return score
 }
 
+// Percentage returns the score as a percentage of the max points, never below zero
+func (s *Submission) Percentage() float64 {
+	if s.MaxPoints <= 0 {
+		return 0
+	}
+
+	percentage := max(0, s.Score()) / s.MaxPoints * 100
+
+	return math.Round(percentage*100) / 100
+}
+
+func (s *Submission) TotalCorrect() int {
+	total := 0
+
+	for _, answer := range s.Answers {
+		if answer.IsCorrect() {
+			total++
+		}
+	}
+
+	return total
+}
+
 func (s *Submission) SetTotalQuestions(total int) {
 	s.TotalQuestions = total
 }
+
+func (s *Submission) SetMaxPoints(maxPoints float64) {
+	s.MaxPoints = maxPoints
+}
+
+// SetDraw pins the submission to the questions it drew
+func (s *Submission) SetDraw(draw *QuestionDraw) {
+	s.QuestionIDs = draw.QuestionIDs
+	s.SetTotalQuestions(draw.TotalQuestions)
+	s.SetMaxPoints(draw.TotalPoints)
+}
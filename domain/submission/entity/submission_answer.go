@@ -15,17 +15,21 @@ type SubmissionAnswer struct {
 	QuestionSlug string
 	Question     string
 	Answer       string
-	IsCorrect    bool
+	Points       float64 // earned points, negative when a wrong answer is penalized
+	MaxPoints    float64
+
+	Pairs []*SubmissionAnswerPair
 }
 
-func NewSubmissionAnswer(submissionID, questionSlug, question, answer string, isCorrect bool) *SubmissionAnswer {
+func NewSubmissionAnswer(submissionID, questionSlug, question, answer string, points float64) *SubmissionAnswer {
 	submissionAnswer := &SubmissionAnswer{
 		ID:           util.GenerateUUID(),
 		SubmissionID: submissionID,
 		QuestionSlug: questionSlug,
 		Question:     question,
 		Answer:       answer,
-		IsCorrect:    isCorrect,
+		Points:       points,
+		MaxPoints:    1,
 	}
 
 	submissionAnswer.MarkCreate()
@@ -33,7 +37,54 @@ func NewSubmissionAnswer(submissionID, questionSlug, question, answer string, is
 	return submissionAnswer
 }
 
-func (sa *SubmissionAnswer) UpdateCorrectness(isCorrect bool) {
-	sa.IsCorrect = isCorrect
+func (sa *SubmissionAnswer) UpdatePoints(points float64) {
+	sa.Points = points
 	sa.MarkUpdate()
 }
+
+// Weigh turns the earned credit into points out of the question's max points.
+// A completely wrong answer loses the given fraction of the max points instead.
+func (sa *SubmissionAnswer) Weigh(maxPoints, penalty float64) {
+	credit := sa.Points
+
+	if credit > 0 {
+		sa.Points = credit * maxPoints
+	} else {
+		sa.Points = -penalty * maxPoints
+	}
+
+	sa.MaxPoints = maxPoints
+}
+
+func (sa *SubmissionAnswer) IsCorrect() bool {
+	return sa.MaxPoints > 0 && sa.Points >= sa.MaxPoints
+}
+
+func (sa *SubmissionAnswer) AddPair(pair *SubmissionAnswerPair) {
+	sa.Pairs = append(sa.Pairs, pair)
+}
+
+// SubmissionAnswerPair records one left item of a matching answer and whether it was paired correctly
+type SubmissionAnswerPair struct {
+	trait.Createable
+
+	ID                 string
+	SubmissionAnswerID string
+	LeftContent        string
+	RightContent       string
+	IsCorrect          bool
+}
+
+func NewSubmissionAnswerPair(submissionAnswerID, leftContent, rightContent string, isCorrect bool) *SubmissionAnswerPair {
+	pair := &SubmissionAnswerPair{
+		ID:                 util.GenerateUUID(),
+		SubmissionAnswerID: submissionAnswerID,
+		LeftContent:        leftContent,
+		RightContent:       rightContent,
+		IsCorrect:          isCorrect,
+	}
+
+	pair.MarkCreate()
+
+	return pair
+}
@@ -0,0 +1,41 @@
+package entity
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSubmissionAnswerWeigh(t *testing.T) {
+	tests := []struct {
+		name        string
+		credit      float64
+		maxPoints   float64
+		penalty     float64
+		wantPoints  float64
+		wantCorrect bool
+	}{
+		{"right", 1, 2, 0.25, 2, true},
+		{"partial credit keeps its share", 0.5, 2, 0.25, 1, false},
+		{"small partial credit is not penalized", 0.1, 4, 0.5, 0.4, false},
+		{"wrong without penalty", 0, 2, 0, 0, false},
+		{"wrong loses the penalty", 0, 2, 0.25, -0.5, false},
+		{"wrong loses the full points", 0, 3, 1, -3, false},
+	}
+
+	for _, tt := range tests {
+		answer := NewSubmissionAnswer("submission", "question", "Question", "Answer", tt.credit)
+		answer.Weigh(tt.maxPoints, tt.penalty)
+
+		if math.Abs(answer.Points-tt.wantPoints) > 1e-9 {
+			t.Errorf("%s: Weigh(%v, %v) on credit %v = %v points, want %v", tt.name, tt.maxPoints, tt.penalty, tt.credit, answer.Points, tt.wantPoints)
+		}
+
+		if answer.MaxPoints != tt.maxPoints {
+			t.Errorf("%s: MaxPoints = %v, want %v", tt.name, answer.MaxPoints, tt.maxPoints)
+		}
+
+		if answer.IsCorrect() != tt.wantCorrect {
+			t.Errorf("%s: IsCorrect() = %v, want %v", tt.name, answer.IsCorrect(), tt.wantCorrect)
+		}
+	}
+}
@@ -2,16 +2,19 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/arvinpaundra/private-api/domain/submission/entity"
 )
 
 type ModuleACL interface {
-	GetCorrectAnswer(ctx context.Context, moduleSlug, questionSlug string) (*entity.Choice, error)
-	GetNextQuestionSlug(ctx context.Context, moduleSlug, currentQuestionSlug string) (*string, error)
+	GetAnswerKey(ctx context.Context, moduleSlug string, version int, questionSlug, submissionCode string) (*entity.AnswerKey, error)
 	GetPublishedModule(ctx context.Context, moduleSlug string) (*entity.Module, error)
-	GetQuestionBySlug(ctx context.Context, moduleSlug, questionSlug string) (*entity.Question, error)
-	GetTotalQuestions(ctx context.Context, moduleSlug string) (int, error)
+	GetQuestionBySlug(ctx context.Context, moduleSlug string, version int, questionSlug, submissionCode string) (*entity.Question, error)
+	DrawQuestions(ctx context.Context, moduleSlug string, version int) (*entity.QuestionDraw, error)
 	GetAllPublishedModules(ctx context.Context, keyword string) ([]*entity.Module, error)
-	GetFirstQuestionSlug(ctx context.Context, moduleSlug string) (*string, error)
+	GetQuestionOrder(ctx context.Context, moduleSlug string, version int, seed int64, questionIDs []string) ([]string, error)
+
+	// GetClosedModules lists the modules that closed at or before the given time, with only their ID and closing time
+	GetClosedModules(ctx context.Context, now time.Time) ([]*entity.Module, error)
 }
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/arvinpaundra/private-api/domain/submission/entity"
 )
@@ -12,4 +13,6 @@ type SubmissionReader interface {
 	TotalSubmissions(ctx context.Context, moduleID, status, keyword string) (int, error)
 	FindAllSubmissions(ctx context.Context, moduleID, status, keyword string, limit, offset int) ([]*entity.Submission, error)
 	FindAllSubmittedGroupedByModule(ctx context.Context, moduleIDs []string) (map[string][]*entity.Submission, error)
+	FindAllInProgress(ctx context.Context, moduleIDs []string) ([]*entity.Submission, error)
+	FindAllOverdue(ctx context.Context, now time.Time) ([]*entity.Submission, error)
 }
@@ -8,4 +8,7 @@ import (
 
 type SubmissionWriter interface {
 	Save(ctx context.Context, submission *entity.Submission) error
+
+	// RemovePreviews deletes the module's preview submissions with their answers
+	RemovePreviews(ctx context.Context, moduleID string) error
 }
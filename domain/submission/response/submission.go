@@ -1,6 +1,8 @@
 package response
 
 import (
+	"time"
+
 	"github.com/arvinpaundra/private-api/domain/submission/constant"
 )
 
@@ -21,11 +23,13 @@ type Module struct {
 }
 
 type SubmissionAnswer struct {
-	ID           string `json:"id"`
-	SubmissionID string `json:"submission_id"`
-	Question     string `json:"question"`
-	Answer       string `json:"answer"`
-	IsCorrect    bool   `json:"is_correct"`
+	ID           string  `json:"id"`
+	SubmissionID string  `json:"submission_id"`
+	Question     string  `json:"question"`
+	Answer       string  `json:"answer"`
+	Points       float64 `json:"points"`
+	MaxPoints    float64 `json:"max_points"`
+	IsCorrect    bool    `json:"is_correct"`
 }
 
 type SubmissionDetail struct {
@@ -38,23 +42,97 @@ type SubmissionDetail struct {
 }
 
 type StartSubmissionResponse struct {
+	Code              string     `json:"code"`
+	Status            string     `json:"status"`
+	FirstQuestionSlug *string    `json:"first_question_slug"`
+	ModuleVersion     int        `json:"module_version"`
+	ExpiresAt         *time.Time `json:"expires_at"`
+	RemainingSeconds  *int       `json:"remaining_seconds"`
+}
+
+// SubmissionProgress is where a student left off, NextQuestionSlug is nil once every question is answered
+type SubmissionProgress struct {
 	Code              string  `json:"code"`
 	Status            string  `json:"status"`
-	FirstQuestionSlug *string `json:"first_question_slug"`
+	ModuleVersion     int     `json:"module_version"`
+	TotalQuestions    int     `json:"total_questions"`
+	AnsweredQuestions int     `json:"answered_questions"`
+	NextQuestionSlug  *string `json:"next_question_slug"`
+	RemainingSeconds  *int    `json:"remaining_seconds"`
 }
 
 type SubmitAnswerResponse struct {
-	IsCorrect            bool    `json:"is_correct"`
-	CorrectChoiceID      string  `json:"correct_choice_id"`
-	CorrectChoiceContent string  `json:"correct_choice_content"`
-	NextQuestionSlug     *string `json:"next_question_slug"`
+	IsCorrect            bool                 `json:"is_correct"`
+	Points               float64              `json:"points"`
+	MaxPoints            float64              `json:"max_points"`
+	CorrectChoiceID      string               `json:"correct_choice_id,omitempty"`
+	CorrectChoiceContent string               `json:"correct_choice_content,omitempty"`
+	CorrectChoices       []*AnswerChoice      `json:"correct_choices,omitempty"`
+	CorrectOrder         []*AnswerChoice      `json:"correct_order,omitempty"`
+	Pairs                []*AnswerPair        `json:"pairs,omitempty"`
+	Rationale            string               `json:"rationale,omitempty"`
+	Explanations         []*ChoiceExplanation `json:"explanations,omitempty"`
+	NextQuestionSlug     *string              `json:"next_question_slug"`
+}
+
+// ChoiceExplanation tells the student why a choice is right or wrong
+type ChoiceExplanation struct {
+	ChoiceID    string `json:"choice_id"`
+	Content     string `json:"content"`
+	IsSelected  bool   `json:"is_selected"`
+	Explanation string `json:"explanation"`
+}
+
+type AnswerChoice struct {
+	ID      string `json:"id"`
+	Content string `json:"content"`
+}
+
+type AnswerPair struct {
+	LeftContent         string `json:"left_content"`
+	RightContent        string `json:"right_content"`
+	CorrectRightContent string `json:"correct_right_content"`
+	IsCorrect           bool   `json:"is_correct"`
 }
 
 type FinalizeSubmissionResponse struct {
-	StudentName string `json:"student_name"`
-	Score       int    `json:"score"`
-	Total       int    `json:"total"`
-	Status      string `json:"status"`
+	StudentName  string  `json:"student_name"`
+	Score        float64 `json:"score"`
+	MaxPoints    float64 `json:"max_points"`
+	Percentage   float64 `json:"percentage"`
+	TotalCorrect int     `json:"total_correct"`
+	Total        int     `json:"total"`
+	Status       string  `json:"status"`
+}
+
+// SubmissionReview walks a finalized submission through every answer with the answer key and its explanations
+type SubmissionReview struct {
+	StudentName  string          `json:"student_name"`
+	Score        float64         `json:"score"`
+	MaxPoints    float64         `json:"max_points"`
+	Percentage   float64         `json:"percentage"`
+	TotalCorrect int             `json:"total_correct"`
+	Total        int             `json:"total"`
+	Answers      []*ReviewAnswer `json:"answers"`
+}
+
+type ReviewAnswer struct {
+	QuestionSlug string          `json:"question_slug"`
+	Question     string          `json:"question"`
+	Answer       string          `json:"answer"`
+	Points       float64         `json:"points"`
+	MaxPoints    float64         `json:"max_points"`
+	IsCorrect    bool            `json:"is_correct"`
+	Rationale    string          `json:"rationale,omitempty"`
+	Choices      []*ReviewChoice `json:"choices,omitempty"`
+}
+
+type ReviewChoice struct {
+	ID              string `json:"id"`
+	Content         string `json:"content"`
+	IsCorrectAnswer bool   `json:"is_correct_answer"`
+	CorrectPosition int    `json:"correct_position,omitempty"`
+	Explanation     string `json:"explanation,omitempty"`
 }
 
 type ModuleSubmissionGroup struct {
@@ -82,8 +160,11 @@ type Subject struct {
 }
 
 type SubmissionSummary struct {
-	StudentName    string `json:"student_name"`
-	TotalCorrect   int    `json:"total_correct"`
-	TotalQuestions int    `json:"total_questions"`
-	SubmittedAt    string `json:"submitted_at"`
+	StudentName    string  `json:"student_name"`
+	TotalCorrect   int     `json:"total_correct"`
+	Score          float64 `json:"score"`
+	MaxPoints      float64 `json:"max_points"`
+	Percentage     float64 `json:"percentage"`
+	TotalQuestions int     `json:"total_questions"`
+	SubmittedAt    string  `json:"submitted_at"`
 }
@@ -0,0 +1,68 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/arvinpaundra/private-api/domain/submission/repository"
+)
+
+// ExpireOverdueSubmissions finalizes the submissions still in progress past their deadline
+// as expired, scored on the answers given in time
+type ExpireOverdueSubmissions struct {
+	submissionReader repository.SubmissionReader
+	uow              repository.UnitOfWork
+}
+
+func NewExpireOverdueSubmissions(
+	submissionReader repository.SubmissionReader,
+	uow repository.UnitOfWork,
+) *ExpireOverdueSubmissions {
+	return &ExpireOverdueSubmissions{
+		submissionReader: submissionReader,
+		uow:              uow,
+	}
+}
+
+// Execute returns how many submissions expired
+func (s *ExpireOverdueSubmissions) Execute(ctx context.Context) (int, error) {
+	submissions, err := s.submissionReader.FindAllOverdue(ctx, time.Now())
+	if err != nil {
+		return 0, err
+	}
+
+	if len(submissions) == 0 {
+		return 0, nil
+	}
+
+	// Save via UnitOfWork, all or none
+	tx, err := s.uow.Begin()
+	if err != nil {
+		return 0, err
+	}
+
+	for _, submission := range submissions {
+		err = submission.Expire()
+		if err != nil {
+			if errRollback := tx.Rollback(); errRollback != nil {
+				return 0, errRollback
+			}
+			return 0, err
+		}
+
+		err = tx.SubmissionWriter().Save(ctx, submission)
+		if err != nil {
+			if errRollback := tx.Rollback(); errRollback != nil {
+				return 0, errRollback
+			}
+			return 0, err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return 0, err
+	}
+
+	return len(submissions), nil
+}
@@ -0,0 +1,92 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/arvinpaundra/private-api/domain/submission/repository"
+)
+
+// FinalizeClosedSubmissions finalizes the submissions still in progress on modules that have closed,
+// scored on the answers given before closing and submitted as of the closing time. A submission whose
+// time ran out before the module closed expires instead, as of its own deadline.
+type FinalizeClosedSubmissions struct {
+	submissionReader repository.SubmissionReader
+	moduleACL        repository.ModuleACL
+	uow              repository.UnitOfWork
+}
+
+func NewFinalizeClosedSubmissions(
+	submissionReader repository.SubmissionReader,
+	moduleACL repository.ModuleACL,
+	uow repository.UnitOfWork,
+) *FinalizeClosedSubmissions {
+	return &FinalizeClosedSubmissions{
+		submissionReader: submissionReader,
+		moduleACL:        moduleACL,
+		uow:              uow,
+	}
+}
+
+// Execute returns how many submissions were finalized
+func (s *FinalizeClosedSubmissions) Execute(ctx context.Context) (int, error) {
+	modules, err := s.moduleACL.GetClosedModules(ctx, time.Now())
+	if err != nil {
+		return 0, err
+	}
+
+	closesAt := make(map[string]time.Time, len(modules))
+	moduleIDs := make([]string, len(modules))
+
+	for i, module := range modules {
+		closesAt[module.ID] = *module.ClosesAt
+		moduleIDs[i] = module.ID
+	}
+
+	submissions, err := s.submissionReader.FindAllInProgress(ctx, moduleIDs)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(submissions) == 0 {
+		return 0, nil
+	}
+
+	// Save via UnitOfWork, all or none
+	tx, err := s.uow.Begin()
+	if err != nil {
+		return 0, err
+	}
+
+	for _, submission := range submissions {
+		moduleClosesAt := closesAt[submission.ModuleID]
+
+		if submission.HasExpired(moduleClosesAt) {
+			err = submission.Expire()
+		} else {
+			err = submission.Finalize(moduleClosesAt)
+		}
+
+		if err != nil {
+			if errRollback := tx.Rollback(); errRollback != nil {
+				return 0, errRollback
+			}
+			return 0, err
+		}
+
+		err = tx.SubmissionWriter().Save(ctx, submission)
+		if err != nil {
+			if errRollback := tx.Rollback(); errRollback != nil {
+				return 0, errRollback
+			}
+			return 0, err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return 0, err
+	}
+
+	return len(submissions), nil
+}
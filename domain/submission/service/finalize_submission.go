@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"github.com/arvinpaundra/private-api/domain/submission/constant"
 	"github.com/arvinpaundra/private-api/domain/submission/repository"
@@ -44,13 +45,26 @@ func (s *FinalizeSubmission) Execute(ctx context.Context, command *FinalizeSubmi
 	}
 
 	// Validate module exists and is published via ACL
-	_, err = s.moduleACL.GetPublishedModule(ctx, command.ModuleSlug)
+	module, err := s.moduleACL.GetPublishedModule(ctx, command.ModuleSlug)
 	if err != nil {
 		return nil, err
 	}
 
-	// Finalize submission
-	err = submission.Finalize()
+	if !submission.BelongsTo(module) {
+		return nil, constant.ErrSubmissionNotFound
+	}
+
+	now := time.Now()
+
+	// Finalize submission, as expired when its time ran out before the student finished
+	// and as of the closing time when the module closed before the worker got to it
+	if submission.HasExpired(now) {
+		err = submission.Expire()
+	} else if module.IsClosed(now) {
+		err = submission.Finalize(*module.ClosesAt)
+	} else {
+		err = submission.Finalize(now)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -76,9 +90,12 @@ func (s *FinalizeSubmission) Execute(ctx context.Context, command *FinalizeSubmi
 
 	// Return finalization response
 	return &response.FinalizeSubmissionResponse{
-		StudentName: submission.StudentName,
-		Score:       submission.Score(),
-		Total:       submission.TotalQuestions,
-		Status:      submission.Status.String(),
+		StudentName:  submission.StudentName,
+		Score:        submission.Score(),
+		MaxPoints:    submission.MaxPoints,
+		Percentage:   submission.Percentage(),
+		TotalCorrect: submission.TotalCorrect(),
+		Total:        submission.TotalQuestions,
+		Status:       submission.Status.String(),
 	}, nil
 }
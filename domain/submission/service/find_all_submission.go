@@ -68,7 +68,10 @@ func (s *FindAllSubmission) Execute(ctx context.Context, query *FindAllSubmissio
 
 			submissionSummaries[i] = &response.SubmissionSummary{
 				StudentName:    submission.StudentName,
-				TotalCorrect:   submission.Score(),
+				TotalCorrect:   submission.TotalCorrect(),
+				Score:          submission.Score(),
+				MaxPoints:      submission.MaxPoints,
+				Percentage:     submission.Percentage(),
 				TotalQuestions: submission.TotalQuestions,
 				SubmittedAt:    submittedAt,
 			}
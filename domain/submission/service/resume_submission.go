@@ -0,0 +1,73 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/arvinpaundra/private-api/domain/submission/constant"
+	"github.com/arvinpaundra/private-api/domain/submission/repository"
+	"github.com/arvinpaundra/private-api/domain/submission/response"
+)
+
+type ResumeSubmissionCommand struct {
+	SubmissionCode string `json:"-" validate:"required"`
+	ModuleSlug     string `json:"-" validate:"required"`
+}
+
+type ResumeSubmission struct {
+	submissionReader repository.SubmissionReader
+	moduleACL        repository.ModuleACL
+}
+
+func NewResumeSubmission(
+	submissionReader repository.SubmissionReader,
+	moduleACL repository.ModuleACL,
+) *ResumeSubmission {
+	return &ResumeSubmission{
+		submissionReader: submissionReader,
+		moduleACL:        moduleACL,
+	}
+}
+
+// Execute tells a student coming back to a submission where they left off
+func (s *ResumeSubmission) Execute(ctx context.Context, command *ResumeSubmissionCommand) (*response.SubmissionProgress, error) {
+	// Find submission by code
+	submission, err := s.submissionReader.FindByCode(ctx, command.SubmissionCode)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate module exists and is published via ACL
+	module, err := s.moduleACL.GetPublishedModule(ctx, command.ModuleSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	if !submission.BelongsTo(module) {
+		return nil, constant.ErrSubmissionNotFound
+	}
+
+	result := &response.SubmissionProgress{
+		Code:              submission.Code,
+		Status:            submission.Status.String(),
+		ModuleVersion:     submission.ModuleVersion,
+		TotalQuestions:    submission.TotalQuestions,
+		AnsweredQuestions: len(submission.Answers),
+		RemainingSeconds:  submission.RemainingSeconds(time.Now()),
+	}
+
+	// A finished submission, or one whose time is up, has nothing left to answer
+	if !submission.IsInProgress() || submission.HasExpired(time.Now()) {
+		return result, nil
+	}
+
+	// Get the order the submission is served the questions in
+	order, err := s.moduleACL.GetQuestionOrder(ctx, module.Slug, submission.ModuleVersion, submission.QuestionSeed, submission.QuestionIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	result.NextQuestionSlug = submission.NextQuestionSlug(order)
+
+	return result, nil
+}
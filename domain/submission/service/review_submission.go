@@ -0,0 +1,95 @@
+package service
+
+import (
+	"context"
+
+	"github.com/arvinpaundra/private-api/domain/submission/constant"
+	"github.com/arvinpaundra/private-api/domain/submission/repository"
+	"github.com/arvinpaundra/private-api/domain/submission/response"
+)
+
+type ReviewSubmissionCommand struct {
+	SubmissionCode string `json:"-" validate:"required"`
+	ModuleSlug     string `json:"-" validate:"required"`
+}
+
+type ReviewSubmission struct {
+	submissionReader repository.SubmissionReader
+	moduleACL        repository.ModuleACL
+}
+
+func NewReviewSubmission(
+	submissionReader repository.SubmissionReader,
+	moduleACL repository.ModuleACL,
+) *ReviewSubmission {
+	return &ReviewSubmission{
+		submissionReader: submissionReader,
+		moduleACL:        moduleACL,
+	}
+}
+
+func (s *ReviewSubmission) Execute(ctx context.Context, command *ReviewSubmissionCommand) (*response.SubmissionReview, error) {
+	// Find submission by code
+	submission, err := s.submissionReader.FindByCode(ctx, command.SubmissionCode)
+	if err != nil {
+		return nil, err
+	}
+
+	// The answer key is only revealed once the student is done
+	if !submission.IsFinalized() {
+		return nil, constant.ErrSubmissionNotDone
+	}
+
+	// Validate module exists and is published via ACL
+	module, err := s.moduleACL.GetPublishedModule(ctx, command.ModuleSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	if !submission.BelongsTo(module) {
+		return nil, constant.ErrSubmissionNotFound
+	}
+
+	answers := make([]*response.ReviewAnswer, len(submission.Answers))
+
+	for i, answer := range submission.Answers {
+		// Review against the version the submission was graded on, choices in the order the student saw them
+		answerKey, err := s.moduleACL.GetAnswerKey(ctx, module.Slug, submission.ModuleVersion, answer.QuestionSlug, submission.Code)
+		if err != nil {
+			return nil, err
+		}
+
+		choices := make([]*response.ReviewChoice, len(answerKey.Choices))
+
+		for j, choice := range answerKey.Choices {
+			choices[j] = &response.ReviewChoice{
+				ID:              choice.ID,
+				Content:         choice.Content,
+				IsCorrectAnswer: choice.IsCorrectAnswer,
+				CorrectPosition: choice.CorrectPosition,
+				Explanation:     choice.Explanation,
+			}
+		}
+
+		answers[i] = &response.ReviewAnswer{
+			QuestionSlug: answer.QuestionSlug,
+			Question:     answer.Question,
+			Answer:       answer.Answer,
+			Points:       answer.Points,
+			MaxPoints:    answer.MaxPoints,
+			IsCorrect:    answer.IsCorrect(),
+			Rationale:    answerKey.Rationale,
+			Choices:      choices,
+		}
+	}
+
+	return &response.SubmissionReview{
+		StudentName:  submission.StudentName,
+		Score:        submission.Score(),
+		MaxPoints:    submission.MaxPoints,
+		Percentage:   submission.Percentage(),
+		TotalCorrect: submission.TotalCorrect(),
+		Total:        submission.TotalQuestions,
+		Answers:      answers,
+	}, nil
+}
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"github.com/arvinpaundra/private-api/domain/submission/entity"
 	"github.com/arvinpaundra/private-api/domain/submission/repository"
@@ -35,22 +36,45 @@ func (s *StartSubmission) Execute(ctx context.Context, command *StartSubmissionC
 		return nil, err
 	}
 
-	// Get total questions count
-	totalQuestions, err := s.moduleACL.GetTotalQuestions(ctx, module.Slug)
+	err = module.CheckOpen(time.Now())
 	if err != nil {
 		return nil, err
 	}
 
-	// Create new submission with generated code
-	submission, err := entity.NewSubmission(module.ID, command.StudentName)
+	// Draw the questions the submission is served, every question unless the module draws fewer
+	draw, err := s.moduleACL.DrawQuestions(ctx, module.Slug, module.Version)
 	if err != nil {
 		return nil, err
 	}
 
-	submission.SetTotalQuestions(totalQuestions)
+	// Create new submission with generated code, pinned to the published version
+	submission, err := entity.NewSubmission(module.ID, module.Version, command.StudentName)
+	if err != nil {
+		return nil, err
+	}
+
+	submission.SetDraw(draw)
+
+	// The clock starts now, answers are taken until the deadline
+	submission.StartTimer(module.TimeLimitMinutes)
+
+	if module.IsPreview {
+		submission.MarkPreview()
+	}
 
-	// Get first question slug
-	firstQuestionSlug, err := s.moduleACL.GetFirstQuestionSlug(ctx, module.Slug)
+	// The choice order is settled for as long as the submission runs, whatever the module setting becomes
+	submission.ShuffleChoices = module.ShuffleChoices
+
+	// Students sitting side by side are each served their own order
+	if module.ShuffleQuestions {
+		err = submission.ShuffleQuestions()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Get the order the submission is served the questions in
+	order, err := s.moduleACL.GetQuestionOrder(ctx, module.Slug, submission.ModuleVersion, submission.QuestionSeed, submission.QuestionIDs)
 	if err != nil {
 		return nil, err
 	}
@@ -61,6 +85,17 @@ func (s *StartSubmission) Execute(ctx context.Context, command *StartSubmissionC
 		return nil, err
 	}
 
+	// Previews are ephemeral, only the last one started on a module is kept
+	if submission.IsPreview {
+		err = tx.SubmissionWriter().RemovePreviews(ctx, module.ID)
+		if err != nil {
+			if errRollback := tx.Rollback(); errRollback != nil {
+				return nil, errRollback
+			}
+			return nil, err
+		}
+	}
+
 	err = tx.SubmissionWriter().Save(ctx, submission)
 	if err != nil {
 		if errRollback := tx.Rollback(); errRollback != nil {
@@ -78,6 +113,9 @@ func (s *StartSubmission) Execute(ctx context.Context, command *StartSubmissionC
 	return &response.StartSubmissionResponse{
 		Code:              submission.Code,
 		Status:            submission.Status.String(),
-		FirstQuestionSlug: firstQuestionSlug,
+		FirstQuestionSlug: submission.NextQuestionSlug(order),
+		ModuleVersion:     submission.ModuleVersion,
+		ExpiresAt:         submission.ExpiresAt,
+		RemainingSeconds:  submission.RemainingSeconds(time.Now()),
 	}, nil
 }
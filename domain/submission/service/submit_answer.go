@@ -2,7 +2,11 @@ package service
 
 import (
 	"context"
+	"slices"
+	"strings"
+	"time"
 
+	"github.com/arvinpaundra/private-api/core/util"
 	"github.com/arvinpaundra/private-api/domain/submission/constant"
 	"github.com/arvinpaundra/private-api/domain/submission/entity"
 	"github.com/arvinpaundra/private-api/domain/submission/repository"
@@ -10,10 +14,20 @@ import (
 )
 
 type SubmitAnswerCommand struct {
-	SubmissionCode string `json:"-" validate:"required"`
-	ModuleSlug     string `json:"-" validate:"required"`
-	QuestionSlug   string `json:"question_slug" validate:"required"`
-	ChoiceID       string `json:"choice_id" validate:"required"`
+	SubmissionCode string              `json:"-" validate:"required"`
+	ModuleSlug     string              `json:"-" validate:"required"`
+	QuestionSlug   string              `json:"question_slug" validate:"required"`
+	ChoiceID       string              `json:"choice_id,omitempty"`
+	ChoiceIDs      []string            `json:"choice_ids,omitempty" validate:"omitempty,unique,dive,required"`
+	Text           string              `json:"text,omitempty" validate:"max=1000"`
+	Unit           string              `json:"unit,omitempty" validate:"max=50"`
+	Order          []string            `json:"order,omitempty" validate:"omitempty,unique,dive,required"`
+	Pairs          []*SubmitAnswerPair `json:"pairs,omitempty" validate:"omitempty,dive"`
+}
+
+type SubmitAnswerPair struct {
+	LeftID  string `json:"left_id" validate:"required"`
+	RightID string `json:"right_id" validate:"required"`
 }
 
 type SubmitAnswer struct {
@@ -57,29 +71,94 @@ func (s *SubmitAnswer) Execute(ctx context.Context, command *SubmitAnswerCommand
 		return nil, err
 	}
 
-	// Get question details to store question content
-	question, err := s.moduleACL.GetQuestionBySlug(ctx, module.Slug, command.QuestionSlug)
+	if !submission.BelongsTo(module) {
+		return nil, constant.ErrSubmissionNotFound
+	}
+
+	// Time is up: the submission is finalized on the answers given so far and this one is turned away
+	if submission.HasExpired(time.Now()) {
+		err = submission.Expire()
+		if err != nil {
+			return nil, err
+		}
+
+		tx, err := s.uow.Begin()
+		if err != nil {
+			return nil, err
+		}
+
+		err = tx.SubmissionWriter().Save(ctx, submission)
+		if err != nil {
+			if errRollback := tx.Rollback(); errRollback != nil {
+				return nil, errRollback
+			}
+			return nil, err
+		}
+
+		err = tx.Commit()
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, constant.ErrSubmissionExpired
+	}
+
+	// No answer is taken once the module closes, the worker finalizes the submission as it stands
+	if module.IsClosed(time.Now()) {
+		return nil, constant.ErrModuleClosed
+	}
+
+	// Get question details from the version the submission started on, among the questions it drew
+	question, err := s.moduleACL.GetQuestionBySlug(ctx, module.Slug, submission.ModuleVersion, command.QuestionSlug, submission.Code)
 	if err != nil {
 		return nil, err
 	}
 
-	// Find the submitted choice to get its content
-	submittedChoice, exist := question.GetChoiceByID(command.ChoiceID)
-	if !exist {
-		return nil, constant.ErrChoiceNotFound
+	// Get answer key from module domain
+	answerKey, err := s.moduleACL.GetAnswerKey(ctx, module.Slug, submission.ModuleVersion, question.Slug, submission.Code)
+	if err != nil {
+		return nil, err
 	}
 
-	// Get correct answer from module domain
-	correctChoice, err := s.moduleACL.GetCorrectAnswer(ctx, module.Slug, question.Slug)
+	var (
+		answer *entity.SubmissionAnswer
+		res    *response.SubmitAnswerResponse
+	)
+
+	// Grade the answer based on question type
+	switch {
+	case question.IsMatching():
+		answer, res, err = gradeMatching(submission.ID, module, question, answerKey, command.Pairs)
+	case question.IsShortAnswer():
+		answer, res, err = gradeShortAnswer(submission.ID, question, answerKey, command.Text)
+	case question.IsNumeric():
+		answer, res, err = gradeNumeric(submission.ID, question, answerKey, command.Text, command.Unit)
+	case question.IsOrdering():
+		answer, res, err = gradeOrdering(submission.ID, module, question, answerKey, command.Order)
+	case question.IsMultipleSelect():
+		answer, res, err = gradeMultipleSelect(submission.ID, module, question, answerKey, command.ChoiceIDs)
+	default:
+		answer, res, err = gradeSingleChoice(submission.ID, question, answerKey, command.ChoiceID)
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	// Determine if answer is correct
-	isCorrect := command.ChoiceID == correctChoice.ID
+	// Scale the credit to the question points, penalizing wrong answers when the version does
+	answer.Weigh(answerKey.Points, answerKey.WrongAnswerPenalty)
 
-	// Create submission answer with question content and choice content
-	answer := entity.NewSubmissionAnswer(submission.ID, question.Slug, question.Content, submittedChoice.Content, isCorrect)
+	res.IsCorrect = answer.IsCorrect()
+	res.Points = answer.Points
+	res.MaxPoints = answer.MaxPoints
+
+	// Explain the answer now that it is given
+	selectedIDs := command.ChoiceIDs
+	if command.ChoiceID != "" {
+		selectedIDs = []string{command.ChoiceID}
+	}
+
+	res.Rationale = answerKey.Rationale
+	res.Explanations = explainChoices(answerKey, selectedIDs)
 
 	// Add answer to submission
 	err = submission.AddAnswer(answer)
@@ -87,6 +166,12 @@ func (s *SubmitAnswer) Execute(ctx context.Context, command *SubmitAnswerCommand
 		return nil, err
 	}
 
+	// Get the order the submission is served the questions in
+	order, err := s.moduleACL.GetQuestionOrder(ctx, module.Slug, submission.ModuleVersion, submission.QuestionSeed, submission.QuestionIDs)
+	if err != nil {
+		return nil, err
+	}
+
 	// Save via UnitOfWork
 	tx, err := s.uow.Begin()
 	if err != nil {
@@ -106,10 +191,312 @@ func (s *SubmitAnswer) Execute(ctx context.Context, command *SubmitAnswerCommand
 		return nil, err
 	}
 
-	return &response.SubmitAnswerResponse{
-		IsCorrect:            isCorrect,
-		CorrectChoiceID:      correctChoice.ID,
-		CorrectChoiceContent: correctChoice.Content,
-		NextQuestionSlug:     question.NextQuestionSlug,
+	res.NextQuestionSlug = submission.NextQuestionSlug(order)
+
+	return res, nil
+}
+
+func gradeSingleChoice(
+	submissionID string,
+	question *entity.Question,
+	answerKey *entity.AnswerKey,
+	choiceID string,
+) (*entity.SubmissionAnswer, *response.SubmitAnswerResponse, error) {
+	if choiceID == "" || len(question.Choices) == 0 {
+		return nil, nil, constant.ErrInvalidAnswerFormat
+	}
+
+	// Find the submitted choice to get its content
+	submittedChoice, exist := question.GetChoiceByID(choiceID)
+	if !exist {
+		return nil, nil, constant.ErrChoiceNotFound
+	}
+
+	res := &response.SubmitAnswerResponse{}
+
+	for _, choice := range answerKey.Choices {
+		if choice.IsCorrectAnswer {
+			res.CorrectChoiceID = choice.ID
+			res.CorrectChoiceContent = choice.Content
+			break
+		}
+	}
+
+	// A single choice question is either fully right or wrong
+	if choiceID == res.CorrectChoiceID {
+		res.Points = 1
+	}
+
+	res.IsCorrect = res.Points == 1
+
+	// Create submission answer with question content and choice content
+	answer := entity.NewSubmissionAnswer(submissionID, question.Slug, question.Content, submittedChoice.Content, res.Points)
+
+	return answer, res, nil
+}
+
+func gradeMultipleSelect(
+	submissionID string,
+	module *entity.Module,
+	question *entity.Question,
+	answerKey *entity.AnswerKey,
+	choiceIDs []string,
+) (*entity.SubmissionAnswer, *response.SubmitAnswerResponse, error) {
+	if len(choiceIDs) == 0 || len(question.Choices) == 0 {
+		return nil, nil, constant.ErrInvalidAnswerFormat
+	}
+
+	selected := make(map[string]bool, len(choiceIDs))
+	for _, choiceID := range choiceIDs {
+		if _, exist := question.GetChoiceByID(choiceID); !exist {
+			return nil, nil, constant.ErrChoiceNotFound
+		}
+
+		selected[choiceID] = true
+	}
+
+	res := &response.SubmitAnswerResponse{}
+
+	// Count the correct choices ticked against the other choices ticked
+	right, wrong, correct := 0, 0, 0
+	answerTexts := make([]string, 0, len(choiceIDs))
+
+	for _, choice := range answerKey.Choices {
+		if selected[choice.ID] {
+			if choice.IsCorrectAnswer {
+				right++
+			} else {
+				wrong++
+			}
+
+			answerTexts = append(answerTexts, choice.Content)
+		}
+
+		if choice.IsCorrectAnswer {
+			correct++
+
+			res.CorrectChoices = append(res.CorrectChoices, &response.AnswerChoice{
+				ID:      choice.ID,
+				Content: choice.Content,
+			})
+		}
+	}
+
+	res.Points = module.SelectionCredit(right, wrong, correct)
+	res.IsCorrect = res.Points == 1
+
+	answer := entity.NewSubmissionAnswer(submissionID, question.Slug, question.Content, strings.Join(answerTexts, ", "), res.Points)
+
+	return answer, res, nil
+}
+
+func gradeOrdering(
+	submissionID string,
+	module *entity.Module,
+	question *entity.Question,
+	answerKey *entity.AnswerKey,
+	order []string,
+) (*entity.SubmissionAnswer, *response.SubmitAnswerResponse, error) {
+	if len(order) == 0 || len(question.Choices) == 0 {
+		return nil, nil, constant.ErrInvalidAnswerFormat
+	}
+
+	// Every item must be placed exactly once
+	if len(order) != len(answerKey.Choices) {
+		return nil, nil, constant.ErrIncompleteOrder
+	}
+
+	correctPositions := make(map[string]int, len(answerKey.Choices))
+	for _, choice := range answerKey.Choices {
+		correctPositions[choice.ID] = choice.CorrectPosition
+	}
+
+	answerTexts := make([]string, len(order))
+	positions := make([]int, len(order))
+
+	for i, choiceID := range order {
+		choice, exist := question.GetChoiceByID(choiceID)
+		if !exist {
+			return nil, nil, constant.ErrChoiceNotFound
+		}
+
+		answerTexts[i] = choice.Content
+		positions[i] = correctPositions[choiceID]
+	}
+
+	res := &response.SubmitAnswerResponse{}
+
+	// Only the exact order is worth everything, so all-or-nothing requires an exact match
+	res.Points = module.OrderCredit(positions)
+	res.IsCorrect = res.Points == 1
+
+	correctOrder := make([]*entity.Choice, len(answerKey.Choices))
+	copy(correctOrder, answerKey.Choices)
+	slices.SortFunc(correctOrder, func(a, b *entity.Choice) int {
+		return a.CorrectPosition - b.CorrectPosition
+	})
+
+	for _, choice := range correctOrder {
+		res.CorrectOrder = append(res.CorrectOrder, &response.AnswerChoice{
+			ID:      choice.ID,
+			Content: choice.Content,
+		})
+	}
+
+	answer := entity.NewSubmissionAnswer(submissionID, question.Slug, question.Content, strings.Join(answerTexts, " > "), res.Points)
+
+	return answer, res, nil
+}
+
+func gradeShortAnswer(
+	submissionID string,
+	question *entity.Question,
+	answerKey *entity.AnswerKey,
+	text string,
+) (*entity.SubmissionAnswer, *response.SubmitAnswerResponse, error) {
+	if strings.TrimSpace(text) == "" || answerKey.Text == nil {
+		return nil, nil, constant.ErrInvalidAnswerFormat
+	}
+
+	res := &response.SubmitAnswerResponse{}
+
+	if answerKey.Text.Matches(text) {
+		res.Points = 1
+	}
+
+	res.IsCorrect = res.Points == 1
+
+	// Keep the raw typed text so teachers see exactly what was written
+	answer := entity.NewSubmissionAnswer(submissionID, question.Slug, question.Content, text, res.Points)
+
+	return answer, res, nil
+}
+
+func gradeNumeric(
+	submissionID string,
+	question *entity.Question,
+	answerKey *entity.AnswerKey,
+	text, unit string,
+) (*entity.SubmissionAnswer, *response.SubmitAnswerResponse, error) {
+	if answerKey.Numeric == nil {
+		return nil, nil, constant.ErrInvalidAnswerFormat
+	}
+
+	// Accept both "3,5" and "3.5"
+	value, err := util.ParseDecimal(text)
+	if err != nil {
+		return nil, nil, constant.ErrInvalidNumber
+	}
+
+	res := &response.SubmitAnswerResponse{}
+
+	if answerKey.Numeric.Matches(value, unit) {
+		res.Points = 1
+	}
+
+	res.IsCorrect = res.Points == 1
+
+	// Keep the raw typed text, with its unit when given
+	answerText := strings.TrimSpace(text)
+	if strings.TrimSpace(unit) != "" {
+		answerText += " " + strings.TrimSpace(unit)
+	}
+
+	answer := entity.NewSubmissionAnswer(submissionID, question.Slug, question.Content, answerText, res.Points)
+
+	return answer, res, nil
+}
+
+func gradeMatching(
+	submissionID string,
+	module *entity.Module,
+	question *entity.Question,
+	answerKey *entity.AnswerKey,
+	submittedPairs []*SubmitAnswerPair,
+) (*entity.SubmissionAnswer, *response.SubmitAnswerResponse, error) {
+	if len(submittedPairs) == 0 || len(question.LeftItems) == 0 {
+		return nil, nil, constant.ErrInvalidAnswerFormat
+	}
+
+	// Every left item must be paired exactly once
+	if len(submittedPairs) != len(question.LeftItems) {
+		return nil, nil, constant.ErrIncompletePairs
+	}
+
+	// Map each left item to the submitted right item
+	submitted := make(map[string]*entity.MatchingItem, len(submittedPairs))
+	for _, pair := range submittedPairs {
+		if _, exist := question.GetLeftItemByID(pair.LeftID); !exist {
+			return nil, nil, constant.ErrPairNotFound
+		}
+
+		rightItem, exist := question.GetRightItemByID(pair.RightID)
+		if !exist {
+			return nil, nil, constant.ErrPairNotFound
+		}
+
+		if _, duplicate := submitted[pair.LeftID]; duplicate {
+			return nil, nil, constant.ErrIncompletePairs
+		}
+
+		submitted[pair.LeftID] = rightItem
+	}
+
+	answer := entity.NewSubmissionAnswer(submissionID, question.Slug, question.Content, "", 0)
+
+	right := 0
+	answerPairs := make([]*response.AnswerPair, 0, len(answerKey.Pairs))
+	answerTexts := make([]string, 0, len(answerKey.Pairs))
+
+	for _, correctPair := range answerKey.Pairs {
+		rightItem, exist := submitted[correctPair.ID]
+		if !exist {
+			return nil, nil, constant.ErrIncompletePairs
+		}
+
+		isCorrect := rightItem.ID == correctPair.RightID
+		if isCorrect {
+			right++
+		}
+
+		answer.AddPair(entity.NewSubmissionAnswerPair(answer.ID, correctPair.LeftContent, rightItem.Content, isCorrect))
+
+		answerTexts = append(answerTexts, correctPair.LeftContent+" = "+rightItem.Content)
+
+		answerPairs = append(answerPairs, &response.AnswerPair{
+			LeftContent:         correctPair.LeftContent,
+			RightContent:        rightItem.Content,
+			CorrectRightContent: correctPair.RightContent,
+			IsCorrect:           isCorrect,
+		})
+	}
+
+	answer.Answer = strings.Join(answerTexts, "; ")
+	answer.Points = module.Credit(right, len(answerKey.Pairs))
+
+	return answer, &response.SubmitAnswerResponse{
+		IsCorrect: answer.IsCorrect(),
+		Points:    answer.Points,
+		Pairs:     answerPairs,
 	}, nil
 }
+
+// explainChoices lists the choices that come with an explanation, marking the ones the student selected
+func explainChoices(answerKey *entity.AnswerKey, selectedIDs []string) []*response.ChoiceExplanation {
+	var explanations []*response.ChoiceExplanation
+
+	for _, choice := range answerKey.Choices {
+		if choice.Explanation == "" {
+			continue
+		}
+
+		explanations = append(explanations, &response.ChoiceExplanation{
+			ChoiceID:    choice.ID,
+			Content:     choice.Content,
+			IsSelected:  slices.Contains(selectedIDs, choice.ID),
+			Explanation: choice.Explanation,
+		})
+	}
+
+	return explanations
+}
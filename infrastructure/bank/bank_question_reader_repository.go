@@ -0,0 +1,167 @@
+package bank
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/arvinpaundra/private-api/domain/bank/constant"
+	"github.com/arvinpaundra/private-api/domain/bank/entity"
+	"github.com/arvinpaundra/private-api/domain/bank/repository"
+	"github.com/arvinpaundra/private-api/model"
+	"gorm.io/gorm"
+)
+
+var _ repository.BankQuestionReader = (*BankQuestionReaderRepository)(nil)
+
+type BankQuestionReaderRepository struct {
+	db *gorm.DB
+}
+
+func NewBankQuestionReaderRepository(db *gorm.DB) *BankQuestionReaderRepository {
+	return &BankQuestionReaderRepository{
+		db: db,
+	}
+}
+
+func (r *BankQuestionReaderRepository) FindByID(ctx context.Context, questionID, userID string) (*entity.BankQuestion, error) {
+	var question model.BankQuestion
+
+	err := r.db.Model(&model.BankQuestion{}).
+		WithContext(ctx).
+		Select("id", "user_id", "subject_id", "grade_id", "type", "content", "body").
+		Where("id = ?", questionID).
+		Where("user_id = ?", userID).
+		Where("deleted_at IS NULL").
+		Preload("Tags", func(db *gorm.DB) *gorm.DB {
+			return db.Order("name ASC")
+		}).
+		First(&question).
+		Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, constant.ErrBankQuestionNotFound
+		}
+		return nil, err
+	}
+
+	return toBankQuestionEntity(&question), nil
+}
+
+func (r *BankQuestionReaderRepository) TotalBankQuestions(ctx context.Context, userID, subjectID, gradeID, tag, keyword string) (int, error) {
+	var total int64
+
+	err := r.db.Model(&model.BankQuestion{}).
+		WithContext(ctx).
+		Select("id").
+		Where("user_id = ?", userID).
+		Where("deleted_at IS NULL").
+		Scopes(r.filter(subjectID, gradeID, tag, keyword)).
+		Count(&total).
+		Error
+
+	if err != nil {
+		return 0, err
+	}
+
+	return int(total), nil
+}
+
+func (r *BankQuestionReaderRepository) FindAllBankQuestions(ctx context.Context, userID, subjectID, gradeID, tag, keyword string, limit, offset int) ([]*entity.BankQuestion, error) {
+	var questions []*model.BankQuestion
+
+	err := r.db.Model(&model.BankQuestion{}).
+		WithContext(ctx).
+		Select("id", "user_id", "subject_id", "grade_id", "type", "content", "body").
+		Where("user_id = ?", userID).
+		Where("deleted_at IS NULL").
+		Scopes(r.filter(subjectID, gradeID, tag, keyword)).
+		Preload("Tags", func(db *gorm.DB) *gorm.DB {
+			return db.Order("name ASC")
+		}).
+		Order("created_at DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&questions).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*entity.BankQuestion, len(questions))
+
+	for i, question := range questions {
+		results[i] = toBankQuestionEntity(question)
+	}
+
+	return results, nil
+}
+
+// filter narrows bank questions down by the optional search parameters
+func (r *BankQuestionReaderRepository) filter(subjectID, gradeID, tag, keyword string) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if keyword != "" {
+			db = db.Where("content ILIKE ?", "%"+keyword+"%")
+		}
+
+		if subjectID != "" {
+			db = db.Where("subject_id = ?", subjectID)
+		}
+
+		if gradeID != "" {
+			db = db.Where("grade_id = ?", gradeID)
+		}
+
+		if tag != "" {
+			db = db.Where("id IN (?)", r.db.Model(&model.BankQuestionTag{}).
+				Select("bank_question_id").
+				Where("name = ?", strings.ToLower(strings.TrimSpace(tag))),
+			)
+		}
+
+		return db
+	}
+}
+
+func toBankQuestionEntity(question *model.BankQuestion) *entity.BankQuestion {
+	result := &entity.BankQuestion{
+		ID:                  question.ID.String(),
+		UserID:              question.UserID.String(),
+		SubjectID:           question.SubjectID.String(),
+		GradeID:             question.GradeID.String(),
+		Type:                constant.QuestionType(question.Type),
+		Content:             question.Content,
+		ContentFormat:       constant.ContentFormat(question.Body.ContentFormat),
+		Rationale:           question.Body.Rationale,
+		Points:              question.Body.Points,
+		CaseSensitive:       question.Body.CaseSensitive,
+		DiacriticsSensitive: question.Body.DiacriticsSensitive,
+		NumericValue:        question.Body.NumericValue,
+		Tolerance:           question.Body.Tolerance,
+		ToleranceType:       constant.ToleranceType(question.Body.ToleranceType),
+	}
+
+	for _, choice := range question.Body.Choices {
+		result.AddChoice(choice.Content, choice.IsCorrectAnswer, choice.CorrectPosition, choice.Explanation)
+	}
+
+	for _, pair := range question.Body.Pairs {
+		result.AddPair(pair.LeftContent, pair.RightContent)
+	}
+
+	for _, answer := range question.Body.AcceptedAnswers {
+		result.AddAcceptedAnswer(answer.Content, answer.IsRegex)
+	}
+
+	for _, unit := range question.Body.Units {
+		result.AddUnit(unit.Name)
+	}
+
+	for _, tag := range question.Tags {
+		result.Tags = append(result.Tags, tag.Name)
+	}
+
+	return result
+}
@@ -0,0 +1,161 @@
+package bank
+
+import (
+	"context"
+	"time"
+
+	"github.com/arvinpaundra/private-api/core/util"
+	"github.com/arvinpaundra/private-api/domain/bank/entity"
+	"github.com/arvinpaundra/private-api/domain/bank/repository"
+	"github.com/arvinpaundra/private-api/model"
+	"github.com/google/uuid"
+	"github.com/guregu/null/v6"
+	"gorm.io/gorm"
+)
+
+var _ repository.BankQuestionWriter = (*BankQuestionWriterRepository)(nil)
+
+type BankQuestionWriterRepository struct {
+	db *gorm.DB
+}
+
+func NewBankQuestionWriterRepository(db *gorm.DB) *BankQuestionWriterRepository {
+	return &BankQuestionWriterRepository{
+		db: db,
+	}
+}
+
+func (r *BankQuestionWriterRepository) Save(ctx context.Context, question *entity.BankQuestion) error {
+	if question.IsUpdated() {
+		return r.update(ctx, question)
+	} else if question.IsRemoved() {
+		return r.remove(ctx, question)
+	}
+
+	return r.insert(ctx, question)
+}
+
+func (r *BankQuestionWriterRepository) insert(ctx context.Context, question *entity.BankQuestion) error {
+	questionModel := model.BankQuestion{
+		ID:        util.ParseUUID(question.ID),
+		UserID:    util.ParseUUID(question.UserID),
+		SubjectID: util.ParseUUID(question.SubjectID),
+		GradeID:   util.ParseUUID(question.GradeID),
+		Type:      model.QuestionType(question.Type),
+		Content:   question.Content,
+		Body:      toQuestionSnapshot(question),
+	}
+
+	err := r.db.Model(&model.BankQuestion{}).WithContext(ctx).Create(&questionModel).Error
+	if err != nil {
+		return err
+	}
+
+	return r.insertTags(ctx, question)
+}
+
+func (r *BankQuestionWriterRepository) update(ctx context.Context, question *entity.BankQuestion) error {
+	// Update bank question fields using map to handle zero values
+	updates := map[string]any{
+		"subject_id": util.ParseUUID(question.SubjectID),
+		"grade_id":   util.ParseUUID(question.GradeID),
+		"type":       model.QuestionType(question.Type),
+		"content":    question.Content,
+		"body":       toQuestionSnapshot(question),
+	}
+
+	err := r.db.Model(&model.BankQuestion{}).WithContext(ctx).Where("id = ?", question.ID).Updates(updates).Error
+	if err != nil {
+		return err
+	}
+
+	// Tags are replaced as a whole
+	err = r.db.Model(&model.BankQuestionTag{}).WithContext(ctx).Where("bank_question_id = ?", question.ID).Delete(&model.BankQuestionTag{}).Error
+	if err != nil {
+		return err
+	}
+
+	return r.insertTags(ctx, question)
+}
+
+func (r *BankQuestionWriterRepository) insertTags(ctx context.Context, question *entity.BankQuestion) error {
+	for _, tag := range question.Tags {
+		tagModel := model.BankQuestionTag{
+			ID:             uuid.New(),
+			BankQuestionID: util.ParseUUID(question.ID),
+			Name:           tag,
+		}
+
+		err := r.db.Model(&model.BankQuestionTag{}).WithContext(ctx).Create(&tagModel).Error
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *BankQuestionWriterRepository) remove(ctx context.Context, question *entity.BankQuestion) error {
+	questionModel := model.BankQuestion{
+		DeletedAt: null.TimeFrom(time.Now().UTC()),
+	}
+
+	err := r.db.Model(&model.BankQuestion{}).WithContext(ctx).Where("id = ?", question.ID).Updates(&questionModel).Error
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// toQuestionSnapshot stores the answers in the format module versions use, choices keep their authored order
+func toQuestionSnapshot(question *entity.BankQuestion) model.QuestionSnapshot {
+	snapshot := model.QuestionSnapshot{
+		Type:                model.QuestionType(question.Type),
+		Content:             question.Content,
+		ContentFormat:       model.ContentFormat(question.ContentFormat),
+		Rationale:           question.Rationale,
+		Points:              question.Points,
+		CaseSensitive:       question.CaseSensitive,
+		DiacriticsSensitive: question.DiacriticsSensitive,
+		NumericValue:        question.NumericValue,
+		Tolerance:           question.Tolerance,
+		ToleranceType:       model.ToleranceType(question.ToleranceType),
+		Choices:             []*model.QuestionChoiceSnapshot{},
+		Pairs:               []*model.QuestionPairSnapshot{},
+		AcceptedAnswers:     []*model.QuestionAcceptedAnswerSnapshot{},
+		Units:               []*model.QuestionUnitSnapshot{},
+	}
+
+	for i, choice := range question.Choices {
+		snapshot.Choices = append(snapshot.Choices, &model.QuestionChoiceSnapshot{
+			Content:         choice.Content,
+			IsCorrectAnswer: choice.IsCorrectAnswer,
+			Position:        i + 1,
+			CorrectPosition: choice.CorrectPosition,
+			Explanation:     choice.Explanation,
+		})
+	}
+
+	for _, pair := range question.Pairs {
+		snapshot.Pairs = append(snapshot.Pairs, &model.QuestionPairSnapshot{
+			LeftContent:  pair.LeftContent,
+			RightContent: pair.RightContent,
+		})
+	}
+
+	for _, answer := range question.AcceptedAnswers {
+		snapshot.AcceptedAnswers = append(snapshot.AcceptedAnswers, &model.QuestionAcceptedAnswerSnapshot{
+			Content: answer.Content,
+			IsRegex: answer.IsRegex,
+		})
+	}
+
+	for _, unit := range question.Units {
+		snapshot.Units = append(snapshot.Units, &model.QuestionUnitSnapshot{
+			Name: unit,
+		})
+	}
+
+	return snapshot
+}
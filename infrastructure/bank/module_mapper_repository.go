@@ -0,0 +1,104 @@
+package bank
+
+import (
+	"context"
+
+	"github.com/arvinpaundra/private-api/domain/bank/entity"
+	"github.com/arvinpaundra/private-api/domain/bank/repository"
+	moduleconstant "github.com/arvinpaundra/private-api/domain/module/constant"
+	"github.com/arvinpaundra/private-api/domain/module/service"
+	"github.com/arvinpaundra/private-api/domain/shared/interfaces"
+	"github.com/arvinpaundra/private-api/infrastructure/module"
+	"gorm.io/gorm"
+)
+
+var _ repository.ModuleACL = (*ModuleACLAdapter)(nil)
+
+type ModuleACLAdapter struct {
+	db          *gorm.DB
+	authStorage interfaces.AuthenticatedUser
+}
+
+func NewModuleACLAdapter(db *gorm.DB, authStorage interfaces.AuthenticatedUser) *ModuleACLAdapter {
+	return &ModuleACLAdapter{
+		db:          db,
+		authStorage: authStorage,
+	}
+}
+
+func (a *ModuleACLAdapter) ValidateQuestion(ctx context.Context, question *entity.BankQuestion) error {
+	moduleService := service.NewValidateQuestion()
+
+	return moduleService.Execute(ctx, &service.ValidateQuestionCommand{
+		Question: toAddQuestion(question),
+	})
+}
+
+func (a *ModuleACLAdapter) ValidateLinkedQuestions(ctx context.Context, question *entity.BankQuestion, userID string) error {
+	moduleService := service.NewSyncBankQuestion(
+		a.authStorage,
+		module.NewModuleReaderRepository(a.db),
+		module.NewUnitOfWork(a.db),
+	)
+
+	return moduleService.Execute(ctx, &service.SyncBankQuestionCommand{
+		BankQuestionID: question.ID,
+		Question:       toAddQuestion(question),
+		ValidateOnly:   true,
+	})
+}
+
+func (a *ModuleACLAdapter) SyncLinkedQuestions(ctx context.Context, question *entity.BankQuestion, userID string) error {
+	moduleService := service.NewSyncBankQuestion(
+		a.authStorage,
+		module.NewModuleReaderRepository(a.db),
+		module.NewUnitOfWork(a.db),
+	)
+
+	return moduleService.Execute(ctx, &service.SyncBankQuestionCommand{
+		BankQuestionID: question.ID,
+		Question:       toAddQuestion(question),
+	})
+}
+
+// toAddQuestion describes the bank question the way the module domain receives questions
+func toAddQuestion(question *entity.BankQuestion) *service.AddQuestion {
+	result := &service.AddQuestion{
+		Type:                moduleconstant.QuestionType(question.Type),
+		Content:             question.Content,
+		ContentFormat:       moduleconstant.ContentFormat(question.ContentFormat),
+		Rationale:           question.Rationale,
+		Points:              question.Points,
+		CaseSensitive:       question.CaseSensitive,
+		DiacriticsSensitive: question.DiacriticsSensitive,
+		NumericValue:        question.NumericValue,
+		Tolerance:           question.Tolerance,
+		ToleranceType:       moduleconstant.ToleranceType(question.ToleranceType),
+		Units:               question.Units,
+	}
+
+	for _, choice := range question.Choices {
+		result.Choices = append(result.Choices, &service.AddQuestionChoice{
+			Content:         choice.Content,
+			IsCorrectAnswer: choice.IsCorrectAnswer,
+			CorrectPosition: choice.CorrectPosition,
+			Explanation:     choice.Explanation,
+		})
+	}
+
+	for _, pair := range question.Pairs {
+		result.Pairs = append(result.Pairs, &service.AddQuestionPair{
+			LeftContent:  pair.LeftContent,
+			RightContent: pair.RightContent,
+		})
+	}
+
+	for _, answer := range question.AcceptedAnswers {
+		result.AcceptedAnswers = append(result.AcceptedAnswers, &service.AddQuestionAcceptedAnswer{
+			Content: answer.Content,
+			IsRegex: answer.IsRegex,
+		})
+	}
+
+	return result
+}
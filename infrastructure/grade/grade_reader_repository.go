@@ -108,6 +108,36 @@ func (r *GradeReaderRepository) FindGradeByID(ctx context.Context, gradeID strin
 	return grade, nil
 }
 
+// FindGradeByName matches the name case-insensitively, the same way duplicate names are detected
+func (r *GradeReaderRepository) FindGradeByName(ctx context.Context, name string, userID string) (*entity.Grade, error) {
+	var gradeModel model.Grade
+
+	err := r.db.Model(&model.Grade{}).
+		WithContext(ctx).
+		Select("id", "name", "description").
+		Where("LOWER(name) = ?", strings.ToLower(name)).
+		Where("user_id = ?", userID).
+		Where("deleted_at IS NULL").
+		First(&gradeModel).
+		Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, constant.ErrGradeNotFound
+		}
+
+		return nil, err
+	}
+
+	grade := &entity.Grade{
+		ID:          gradeModel.ID.String(),
+		Name:        gradeModel.Name,
+		Description: gradeModel.Description.Ptr(),
+	}
+
+	return grade, nil
+}
+
 func (r *GradeReaderRepository) AllGrades(ctx context.Context, userID string, keyword string) ([]*entity.Grade, error) {
 	var gradeModels []model.Grade
 
@@ -0,0 +1,52 @@
+package module
+
+import (
+	"context"
+	"errors"
+
+	"github.com/arvinpaundra/private-api/domain/module/constant"
+	"github.com/arvinpaundra/private-api/domain/module/entity"
+	"github.com/arvinpaundra/private-api/domain/module/repository"
+	"github.com/arvinpaundra/private-api/model"
+	"gorm.io/gorm"
+)
+
+var _ repository.BankACL = (*BankACLAdapter)(nil)
+
+// BankACLAdapter reads bank questions straight from storage, the bank infrastructure
+// already depends on this package to propagate edits into modules
+type BankACLAdapter struct {
+	db *gorm.DB
+}
+
+func NewBankACLAdapter(db *gorm.DB) *BankACLAdapter {
+	return &BankACLAdapter{
+		db: db,
+	}
+}
+
+func (a *BankACLAdapter) GetBankQuestion(ctx context.Context, bankQuestionID string, userID string) (*entity.Question, error) {
+	var bankQuestion model.BankQuestion
+
+	err := a.db.Model(&model.BankQuestion{}).
+		WithContext(ctx).
+		Select("id", "type", "content", "body").
+		Where("id = ?", bankQuestionID).
+		Where("user_id = ?", userID).
+		Where("deleted_at IS NULL").
+		First(&bankQuestion).
+		Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, constant.ErrBankQuestionNotFound
+		}
+		return nil, err
+	}
+
+	question := toQuestionEntity("", &bankQuestion.Body)
+	question.Type = constant.QuestionType(bankQuestion.Type)
+	question.Content = bankQuestion.Content
+
+	return question, nil
+}
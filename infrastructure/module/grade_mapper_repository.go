@@ -71,3 +71,20 @@ func (a *GradeACLAdapter) GetGradeNames(ctx context.Context, gradeIDs []string,
 
 	return names, nil
 }
+
+func (a *GradeACLAdapter) FindOrCreateGrade(ctx context.Context, name string, userID string) (string, error) {
+	gradeService := service.NewFindOrCreateGrade(
+		a.authStorage,
+		grade.NewGradeReaderRepository(a.db),
+		grade.NewGradeWriterRepository(a.db),
+	)
+
+	gradeID, err := gradeService.Execute(ctx, &service.FindOrCreateGradeCommand{
+		Name: name,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return gradeID, nil
+}
@@ -13,6 +13,13 @@ import (
 
 var _ repository.ModuleReader = (*ModuleReaderRepository)(nil)
 
+// moduleColumns are the module columns loaded into every module entity
+var moduleColumns = []string{
+	"id", "user_id", "subject_id", "grade_id", "title", "slug", "description", "type", "scoring_policy",
+	"wrong_answer_penalty", "shuffle_questions", "shuffle_choices", "draw_count", "stratify_draw",
+	"time_limit_minutes", "opens_at", "closes_at", "is_published", "published_version",
+}
+
 type ModuleReaderRepository struct {
 	db *gorm.DB
 }
@@ -28,7 +35,7 @@ func (r *ModuleReaderRepository) FindByID(ctx context.Context, moduleID, userID
 
 	err := r.db.Model(&model.Module{}).
 		WithContext(ctx).
-		Select("id", "user_id", "subject_id", "grade_id", "title", "slug", "description", "type", "is_published").
+		Select(moduleColumns).
 		Where("id = ?", moduleID).
 		Where("user_id = ?", userID).
 		Where("deleted_at IS NULL").
@@ -42,17 +49,7 @@ func (r *ModuleReaderRepository) FindByID(ctx context.Context, moduleID, userID
 		return nil, err
 	}
 
-	return &entity.Module{
-		ID:          module.ID.String(),
-		UserID:      module.UserID.String(),
-		SubjectID:   module.SubjectID.String(),
-		GradeID:     module.GradeID.String(),
-		Title:       module.Title,
-		Slug:        module.Slug,
-		Description: module.Description.Ptr(),
-		Type:        constant.ModuleType(module.Type),
-		IsPublished: module.IsPublished,
-	}, nil
+	return toModuleEntity(&module), nil
 }
 
 func (r *ModuleReaderRepository) FindBySlug(ctx context.Context, slug, userID string) (*entity.Module, error) {
@@ -60,7 +57,7 @@ func (r *ModuleReaderRepository) FindBySlug(ctx context.Context, slug, userID st
 
 	err := r.db.Model(&model.Module{}).
 		WithContext(ctx).
-		Select("id", "user_id", "subject_id", "grade_id", "title", "slug", "description", "type", "is_published").
+		Select(moduleColumns).
 		Where("slug = ?", slug).
 		Where("user_id = ?", userID).
 		Where("deleted_at IS NULL").
@@ -74,17 +71,7 @@ func (r *ModuleReaderRepository) FindBySlug(ctx context.Context, slug, userID st
 		return nil, err
 	}
 
-	return &entity.Module{
-		ID:          module.ID.String(),
-		UserID:      module.UserID.String(),
-		SubjectID:   module.SubjectID.String(),
-		GradeID:     module.GradeID.String(),
-		Title:       module.Title,
-		Slug:        module.Slug,
-		Description: module.Description.Ptr(),
-		Type:        constant.ModuleType(module.Type),
-		IsPublished: module.IsPublished,
-	}, nil
+	return toModuleEntity(&module), nil
 }
 
 func (r *ModuleReaderRepository) FindModuleDetailBySlug(ctx context.Context, slug, userID string) (*entity.Module, error) {
@@ -92,12 +79,22 @@ func (r *ModuleReaderRepository) FindModuleDetailBySlug(ctx context.Context, slu
 
 	err := r.db.Model(&model.Module{}).
 		WithContext(ctx).
-		Select("id", "user_id", "subject_id", "grade_id", "title", "slug", "description", "type", "is_published").
+		Select(moduleColumns).
 		Where("slug = ?", slug).
 		Where("user_id = ?", userID).
 		Where("deleted_at IS NULL").
-		Preload("Questions", "deleted_at IS NULL").
-		Preload("Questions.Choices", "deleted_at IS NULL").
+		Preload("Questions", func(db *gorm.DB) *gorm.DB {
+			return db.Where("deleted_at IS NULL").Order("position ASC, created_at ASC")
+		}).
+		Preload("Questions.Choices", func(db *gorm.DB) *gorm.DB {
+			return db.Where("deleted_at IS NULL").Order("position ASC")
+		}).
+		Preload("Questions.Pairs", "deleted_at IS NULL").
+		Preload("Questions.AcceptedAnswers", "deleted_at IS NULL").
+		Preload("Questions.Units", "deleted_at IS NULL").
+		Preload("Questions.Attachments", func(db *gorm.DB) *gorm.DB {
+			return db.Where("deleted_at IS NULL").Order("created_at ASC")
+		}).
 		First(&module).
 		Error
 
@@ -112,38 +109,13 @@ func (r *ModuleReaderRepository) FindModuleDetailBySlug(ctx context.Context, slu
 	questions := make([]*entity.Question, len(module.Questions))
 
 	for i, question := range module.Questions {
-		choices := make([]*entity.QuestionChoice, len(question.Choices))
-
-		for j, choice := range question.Choices {
-			choices[j] = &entity.QuestionChoice{
-				ID:              choice.ID.String(),
-				QuestionID:      choice.QuestionID.String(),
-				Content:         choice.Content,
-				IsCorrectAnswer: choice.IsCorrectAnswer,
-			}
-		}
-
-		questions[i] = &entity.Question{
-			ID:       question.ID.String(),
-			ModuleID: question.ModuleID.String(),
-			Content:  question.Content,
-			Slug:     question.Slug,
-			Choices:  choices,
-		}
+		questions[i] = toQuestionEntityFromModel(question)
 	}
 
-	return &entity.Module{
-		ID:          module.ID.String(),
-		UserID:      module.UserID.String(),
-		SubjectID:   module.SubjectID.String(),
-		GradeID:     module.GradeID.String(),
-		Title:       module.Title,
-		Slug:        module.Slug,
-		Description: module.Description.Ptr(),
-		Type:        constant.ModuleType(module.Type),
-		IsPublished: module.IsPublished,
-		Questions:   questions,
-	}, nil
+	result := toModuleEntity(&module)
+	result.Questions = questions
+
+	return result, nil
 }
 
 func (r *ModuleReaderRepository) TotalModules(ctx context.Context, userID, subjectID, gradeID, keyword string) (int, error) {
@@ -213,9 +185,7 @@ func (r *ModuleReaderRepository) FindAllModules(ctx context.Context, userID, sub
 			}
 			return db
 		}).
-		Select("modules.id", "modules.user_id", "modules.subject_id", "modules.grade_id",
-			"modules.title", "modules.description", "modules.is_published", "modules.slug", "modules.type",
-			"COUNT(questions.id) as questions_count").
+		Select(append(qualifiedModuleColumns("modules"), "COUNT(questions.id) as questions_count")).
 		Joins("LEFT JOIN questions ON questions.module_id = modules.id AND questions.deleted_at IS NULL").
 		Group("modules.id").
 		Order("modules.created_at DESC").
@@ -234,29 +204,69 @@ func (r *ModuleReaderRepository) FindAllModules(ctx context.Context, userID, sub
 		// Create question placeholders to represent count
 		questions := make([]*entity.Question, m.QuestionsCount)
 
-		modules[i] = &entity.Module{
-			ID:          m.Module.ID.String(),
-			UserID:      m.Module.UserID.String(),
-			SubjectID:   m.Module.SubjectID.String(),
-			GradeID:     m.Module.GradeID.String(),
-			Title:       m.Module.Title,
-			Slug:        m.Slug,
-			Description: m.Module.Description.Ptr(),
-			Type:        constant.ModuleType(m.Module.Type),
-			IsPublished: m.Module.IsPublished,
-			Questions:   questions,
-		}
+		modules[i] = toModuleEntity(&m.Module)
+		modules[i].Questions = questions
 	}
 
 	return modules, nil
 }
 
+// FindUnpublishedModulesLinkedTo loads the user's unpublished modules with only their questions that reference the bank question
+func (r *ModuleReaderRepository) FindUnpublishedModulesLinkedTo(ctx context.Context, bankQuestionID, userID string) ([]*entity.Module, error) {
+	var modules []*model.Module
+
+	err := r.db.Model(&model.Module{}).
+		WithContext(ctx).
+		Select(moduleColumns).
+		Where("user_id = ?", userID).
+		Where("is_published = ?", false).
+		Where("deleted_at IS NULL").
+		Where("id IN (?)", r.db.Model(&model.Question{}).
+			Select("module_id").
+			Where("bank_question_id = ?", bankQuestionID).
+			Where("deleted_at IS NULL"),
+		).
+		Preload("Questions", func(db *gorm.DB) *gorm.DB {
+			return db.Where("bank_question_id = ?", bankQuestionID).Where("deleted_at IS NULL")
+		}).
+		Preload("Questions.Choices", func(db *gorm.DB) *gorm.DB {
+			return db.Where("deleted_at IS NULL").Order("position ASC")
+		}).
+		Preload("Questions.Pairs", "deleted_at IS NULL").
+		Preload("Questions.AcceptedAnswers", "deleted_at IS NULL").
+		Preload("Questions.Units", "deleted_at IS NULL").
+		Preload("Questions.Attachments", func(db *gorm.DB) *gorm.DB {
+			return db.Where("deleted_at IS NULL").Order("created_at ASC")
+		}).
+		Find(&modules).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*entity.Module, len(modules))
+
+	for i, module := range modules {
+		questions := make([]*entity.Question, len(module.Questions))
+
+		for j, question := range module.Questions {
+			questions[j] = toQuestionEntityFromModel(question)
+		}
+
+		results[i] = toModuleEntity(module)
+		results[i].Questions = questions
+	}
+
+	return results, nil
+}
+
 func (r *ModuleReaderRepository) FindPublishedModuleBySlug(ctx context.Context, slug string) (*entity.Module, error) {
 	var module model.Module
 
 	err := r.db.Model(&model.Module{}).
 		WithContext(ctx).
-		Select("id", "user_id", "subject_id", "grade_id", "title", "slug", "description", "type", "is_published").
+		Select(moduleColumns).
 		Where("slug = ?", slug).
 		Where("is_published = true").
 		Where("deleted_at IS NULL").
@@ -270,91 +280,63 @@ func (r *ModuleReaderRepository) FindPublishedModuleBySlug(ctx context.Context,
 		return nil, err
 	}
 
-	return &entity.Module{
-		ID:          module.ID.String(),
-		UserID:      module.UserID.String(),
-		SubjectID:   module.SubjectID.String(),
-		GradeID:     module.GradeID.String(),
-		Title:       module.Title,
-		Slug:        module.Slug,
-		Description: module.Description.Ptr(),
-		Type:        constant.ModuleType(module.Type),
-		IsPublished: module.IsPublished,
-	}, nil
+	return toModuleEntity(&module), nil
 }
 
-func (r *ModuleReaderRepository) FindPublishedQuestionBySlug(ctx context.Context, moduleSlug, questionSlug string) (*entity.Question, error) {
-	var question model.Question
+// FindPublishedVersion loads a frozen version of a published module, version 0 loads the one currently served
+func (r *ModuleReaderRepository) FindPublishedVersion(ctx context.Context, moduleSlug string, version int) (*entity.ModuleVersion, error) {
+	var module model.Module
 
-	err := r.db.Model(&model.Question{}).
+	err := r.db.Model(&model.Module{}).
 		WithContext(ctx).
-		Select("questions.id", "questions.module_id", "questions.content", "questions.slug").
-		Joins("JOIN modules ON modules.id = questions.module_id").
-		Where("modules.slug = ?", moduleSlug).
-		Where("modules.is_published = true").
-		Where("modules.deleted_at IS NULL").
-		Where("questions.slug = ?", questionSlug).
-		Where("questions.deleted_at IS NULL").
-		Preload("Choices", "deleted_at IS NULL").
-		First(&question).
+		Select("id", "published_version").
+		Where("slug = ?", moduleSlug).
+		Where("is_published = true").
+		Where("deleted_at IS NULL").
+		First(&module).
 		Error
 
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, constant.ErrQuestionNotFound
+			return nil, constant.ErrModuleNotFound
 		}
 		return nil, err
 	}
 
-	// Convert choices to domain entities
-	choices := make([]*entity.QuestionChoice, len(question.Choices))
-
-	for i, choice := range question.Choices {
-		choices[i] = &entity.QuestionChoice{
-			ID:              choice.ID.String(),
-			QuestionID:      choice.QuestionID.String(),
-			Content:         choice.Content,
-			IsCorrectAnswer: choice.IsCorrectAnswer,
-		}
+	if version == 0 {
+		version = module.PublishedVersion
 	}
 
-	return &entity.Question{
-		ID:       question.ID.String(),
-		ModuleID: question.ModuleID.String(),
-		Content:  question.Content,
-		Slug:     question.Slug,
-		Choices:  choices,
-	}, nil
-}
-
-func (r *ModuleReaderRepository) FindNextPublishedQuestion(ctx context.Context, moduleSlug, currentQuestionSlug string) (*entity.Question, error) {
-	var nextQuestion model.Question
+	var moduleVersion model.ModuleVersion
 
-	err := r.db.Model(&model.Question{}).
+	err = r.db.Model(&model.ModuleVersion{}).
 		WithContext(ctx).
-		Select("questions.id", "questions.module_id", "questions.content", "questions.slug").
-		Joins("JOIN modules ON modules.id = questions.module_id").
-		Where("modules.slug = ?", moduleSlug).
-		Where("modules.is_published = true").
-		Where("modules.deleted_at IS NULL").
-		Where("questions.deleted_at IS NULL").
-		Where("questions.created_at > (SELECT created_at FROM questions WHERE slug = ? AND deleted_at IS NULL)", currentQuestionSlug).
-		Order("questions.created_at ASC").
-		First(&nextQuestion).
+		Where("module_id = ?", module.ID).
+		Where("version = ?", version).
+		First(&moduleVersion).
 		Error
 
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, nil
+			return nil, constant.ErrVersionNotFound
 		}
 		return nil, err
 	}
 
-	return &entity.Question{
-		ID:       nextQuestion.ID.String(),
-		ModuleID: nextQuestion.ModuleID.String(),
-		Content:  nextQuestion.Content,
-		Slug:     nextQuestion.Slug,
+	questions := make([]*entity.Question, len(moduleVersion.Questions))
+
+	for i, question := range moduleVersion.Questions {
+		questions[i] = toQuestionEntity(moduleVersion.ModuleID.String(), question)
+	}
+
+	return &entity.ModuleVersion{
+		ID:                 moduleVersion.ID.String(),
+		ModuleID:           moduleVersion.ModuleID.String(),
+		Version:            moduleVersion.Version,
+		WrongAnswerPenalty: moduleVersion.WrongAnswerPenalty,
+		DrawCount:          moduleVersion.DrawCount,
+		StratifyDraw:       moduleVersion.StratifyDraw,
+		Questions:          questions,
 	}, nil
 }
 
@@ -394,3 +376,215 @@ func (r *ModuleReaderRepository) CountByUserID(ctx context.Context, userID strin
 
 	return int(count), nil
 }
+
+// toQuestionEntityFromModel converts a stored question and its loaded answers
+// qualifiedModuleColumns prefixes the module columns with the table name for queries joining other tables
+func qualifiedModuleColumns(table string) []string {
+	columns := make([]string, len(moduleColumns))
+
+	for i, column := range moduleColumns {
+		columns[i] = table + "." + column
+	}
+
+	return columns
+}
+
+func toModuleEntity(module *model.Module) *entity.Module {
+	return &entity.Module{
+		ID:                 module.ID.String(),
+		UserID:             module.UserID.String(),
+		SubjectID:          module.SubjectID.String(),
+		GradeID:            module.GradeID.String(),
+		Title:              module.Title,
+		Slug:               module.Slug,
+		Description:        module.Description.Ptr(),
+		Type:               constant.ModuleType(module.Type),
+		ScoringPolicy:      constant.ScoringPolicy(module.ScoringPolicy),
+		WrongAnswerPenalty: module.WrongAnswerPenalty,
+		ShuffleQuestions:   module.ShuffleQuestions,
+		ShuffleChoices:     module.ShuffleChoices,
+		DrawCount:          module.DrawCount,
+		StratifyDraw:       module.StratifyDraw,
+		TimeLimitMinutes:   module.TimeLimitMinutes,
+		OpensAt:            module.OpensAt.Ptr(),
+		ClosesAt:           module.ClosesAt.Ptr(),
+		IsPublished:        module.IsPublished,
+		PublishedVersion:   module.PublishedVersion,
+	}
+}
+
+func toQuestionEntityFromModel(question *model.Question) *entity.Question {
+	choices := make([]*entity.QuestionChoice, len(question.Choices))
+
+	for i, choice := range question.Choices {
+		choices[i] = &entity.QuestionChoice{
+			ID:              choice.ID.String(),
+			QuestionID:      choice.QuestionID.String(),
+			Content:         choice.Content,
+			IsCorrectAnswer: choice.IsCorrectAnswer,
+			Position:        choice.Position,
+			CorrectPosition: choice.CorrectPosition,
+			Explanation:     choice.Explanation,
+		}
+	}
+
+	return &entity.Question{
+		ID:                  question.ID.String(),
+		ModuleID:            question.ModuleID.String(),
+		Type:                constant.QuestionType(question.Type),
+		Content:             question.Content,
+		ContentFormat:       constant.ContentFormat(question.ContentFormat),
+		Rationale:           question.Rationale,
+		Tag:                 question.Tag,
+		Slug:                question.Slug,
+		Position:            question.Position,
+		Points:              question.Points,
+		CaseSensitive:       question.CaseSensitive,
+		DiacriticsSensitive: question.DiacriticsSensitive,
+		Choices:             choices,
+		Pairs:               toPairEntities(question.Pairs),
+		AcceptedAnswers:     toAcceptedAnswerEntities(question.AcceptedAnswers),
+		NumericValue:        question.NumericValue.Ptr(),
+		Tolerance:           question.Tolerance,
+		ToleranceType:       constant.ToleranceType(question.ToleranceType),
+		Units:               toUnitEntities(question.Units),
+		Attachments:         toAttachmentEntities(question.Attachments),
+		BankQuestionID:      question.BankQuestionID.Ptr(),
+	}
+}
+
+func toPairEntities(pairModels []*model.QuestionPair) []*entity.QuestionPair {
+	pairs := make([]*entity.QuestionPair, len(pairModels))
+
+	for i, pair := range pairModels {
+		pairs[i] = &entity.QuestionPair{
+			ID:           pair.ID.String(),
+			QuestionID:   pair.QuestionID.String(),
+			LeftContent:  pair.LeftContent,
+			RightID:      pair.RightID.String(),
+			RightContent: pair.RightContent,
+		}
+	}
+
+	return pairs
+}
+
+func toAcceptedAnswerEntities(answerModels []*model.QuestionAcceptedAnswer) []*entity.QuestionAcceptedAnswer {
+	answers := make([]*entity.QuestionAcceptedAnswer, len(answerModels))
+
+	for i, answer := range answerModels {
+		answers[i] = &entity.QuestionAcceptedAnswer{
+			ID:         answer.ID.String(),
+			QuestionID: answer.QuestionID.String(),
+			Content:    answer.Content,
+			IsRegex:    answer.IsRegex,
+		}
+	}
+
+	return answers
+}
+
+func toUnitEntities(unitModels []*model.QuestionUnit) []*entity.QuestionUnit {
+	units := make([]*entity.QuestionUnit, len(unitModels))
+
+	for i, unit := range unitModels {
+		units[i] = &entity.QuestionUnit{
+			ID:         unit.ID.String(),
+			QuestionID: unit.QuestionID.String(),
+			Name:       unit.Name,
+		}
+	}
+
+	return units
+}
+
+func toAttachmentEntities(attachmentModels []*model.QuestionAttachment) []*entity.QuestionAttachment {
+	attachments := make([]*entity.QuestionAttachment, len(attachmentModels))
+
+	for i, attachment := range attachmentModels {
+		attachments[i] = &entity.QuestionAttachment{
+			ID:             attachment.ID.String(),
+			QuestionID:     attachment.QuestionID.String(),
+			ChoicePosition: attachment.ChoicePosition,
+			StorageKey:     attachment.StorageKey,
+			Filename:       attachment.Filename,
+			ContentType:    attachment.ContentType,
+			Size:           attachment.Size,
+		}
+	}
+
+	return attachments
+}
+
+func toQuestionEntity(moduleID string, snapshot *model.QuestionSnapshot) *entity.Question {
+	question := &entity.Question{
+		ID:                  snapshot.ID,
+		ModuleID:            moduleID,
+		Type:                constant.QuestionType(snapshot.Type),
+		Content:             snapshot.Content,
+		ContentFormat:       constant.ContentFormat(snapshot.ContentFormat),
+		Rationale:           snapshot.Rationale,
+		Tag:                 snapshot.Tag,
+		Slug:                snapshot.Slug,
+		Position:            snapshot.Position,
+		Points:              snapshot.Points,
+		CaseSensitive:       snapshot.CaseSensitive,
+		DiacriticsSensitive: snapshot.DiacriticsSensitive,
+		NumericValue:        snapshot.NumericValue,
+		Tolerance:           snapshot.Tolerance,
+		ToleranceType:       constant.ToleranceType(snapshot.ToleranceType),
+	}
+
+	for _, choice := range snapshot.Choices {
+		question.Choices = append(question.Choices, &entity.QuestionChoice{
+			ID:              choice.ID,
+			QuestionID:      snapshot.ID,
+			Content:         choice.Content,
+			IsCorrectAnswer: choice.IsCorrectAnswer,
+			Position:        choice.Position,
+			CorrectPosition: choice.CorrectPosition,
+			Explanation:     choice.Explanation,
+		})
+	}
+
+	for _, pair := range snapshot.Pairs {
+		question.Pairs = append(question.Pairs, &entity.QuestionPair{
+			ID:           pair.ID,
+			QuestionID:   snapshot.ID,
+			LeftContent:  pair.LeftContent,
+			RightID:      pair.RightID,
+			RightContent: pair.RightContent,
+		})
+	}
+
+	for _, answer := range snapshot.AcceptedAnswers {
+		question.AcceptedAnswers = append(question.AcceptedAnswers, &entity.QuestionAcceptedAnswer{
+			ID:         answer.ID,
+			QuestionID: snapshot.ID,
+			Content:    answer.Content,
+			IsRegex:    answer.IsRegex,
+		})
+	}
+
+	for _, unit := range snapshot.Units {
+		question.Units = append(question.Units, &entity.QuestionUnit{
+			ID:         unit.ID,
+			QuestionID: snapshot.ID,
+			Name:       unit.Name,
+		})
+	}
+
+	for _, attachment := range snapshot.Attachments {
+		question.Attachments = append(question.Attachments, &entity.QuestionAttachment{
+			ID:             attachment.ID,
+			QuestionID:     snapshot.ID,
+			ChoicePosition: attachment.ChoicePosition,
+			StorageKey:     attachment.StorageKey,
+			Filename:       attachment.Filename,
+			ContentType:    attachment.ContentType,
+			Size:           attachment.Size,
+		})
+	}
+
+	return question
+}
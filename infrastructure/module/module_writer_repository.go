@@ -36,15 +36,24 @@ func (r *ModuleWriterRepository) Save(ctx context.Context, module *entity.Module
 
 func (r *ModuleWriterRepository) insert(ctx context.Context, module *entity.Module) error {
 	moduleModel := model.Module{
-		ID:          util.ParseUUID(module.ID),
-		UserID:      util.ParseUUID(module.UserID),
-		SubjectID:   util.ParseUUID(module.SubjectID),
-		GradeID:     util.ParseUUID(module.GradeID),
-		Title:       module.Title,
-		Slug:        module.Slug,
-		Description: null.StringFromPtr(module.Description),
-		Type:        model.ModuleType(module.Type),
-		IsPublished: module.IsPublished,
+		ID:                 util.ParseUUID(module.ID),
+		UserID:             util.ParseUUID(module.UserID),
+		SubjectID:          util.ParseUUID(module.SubjectID),
+		GradeID:            util.ParseUUID(module.GradeID),
+		Title:              module.Title,
+		Slug:               module.Slug,
+		Description:        null.StringFromPtr(module.Description),
+		Type:               model.ModuleType(module.Type),
+		ScoringPolicy:      model.ScoringPolicy(module.ScoringPolicy),
+		WrongAnswerPenalty: module.WrongAnswerPenalty,
+		ShuffleQuestions:   module.ShuffleQuestions,
+		ShuffleChoices:     module.ShuffleChoices,
+		DrawCount:          module.DrawCount,
+		StratifyDraw:       module.StratifyDraw,
+		TimeLimitMinutes:   module.TimeLimitMinutes,
+		OpensAt:            null.TimeFromPtr(module.OpensAt),
+		ClosesAt:           null.TimeFromPtr(module.ClosesAt),
+		IsPublished:        module.IsPublished,
 	}
 
 	err := r.db.Model(&model.Module{}).WithContext(ctx).Create(&moduleModel).Error
@@ -52,19 +61,37 @@ func (r *ModuleWriterRepository) insert(ctx context.Context, module *entity.Modu
 		return err
 	}
 
+	// Insert questions that come along with a new module, such as a clone
+	for _, question := range module.Questions {
+		err := r.insertQuestion(ctx, question)
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
 func (r *ModuleWriterRepository) update(ctx context.Context, module *entity.Module) error {
 	// Update module fields using map to handle zero values
 	updates := map[string]any{
-		"subject_id":   util.ParseUUID(module.SubjectID),
-		"grade_id":     util.ParseUUID(module.GradeID),
-		"title":        module.Title,
-		"slug":         module.Slug,
-		"description":  null.StringFromPtr(module.Description),
-		"type":         model.ModuleType(module.Type),
-		"is_published": module.IsPublished,
+		"subject_id":           util.ParseUUID(module.SubjectID),
+		"grade_id":             util.ParseUUID(module.GradeID),
+		"title":                module.Title,
+		"slug":                 module.Slug,
+		"description":          null.StringFromPtr(module.Description),
+		"type":                 model.ModuleType(module.Type),
+		"scoring_policy":       model.ScoringPolicy(module.ScoringPolicy),
+		"wrong_answer_penalty": module.WrongAnswerPenalty,
+		"shuffle_questions":    module.ShuffleQuestions,
+		"shuffle_choices":      module.ShuffleChoices,
+		"draw_count":           module.DrawCount,
+		"stratify_draw":        module.StratifyDraw,
+		"time_limit_minutes":   module.TimeLimitMinutes,
+		"opens_at":             null.TimeFromPtr(module.OpensAt),
+		"closes_at":            null.TimeFromPtr(module.ClosesAt),
+		"is_published":         module.IsPublished,
+		"published_version":    module.PublishedVersion,
 	}
 
 	err := r.db.Model(&model.Module{}).WithContext(ctx).Where("id = ?", module.ID).Updates(updates).Error
@@ -72,41 +99,38 @@ func (r *ModuleWriterRepository) update(ctx context.Context, module *entity.Modu
 		return err
 	}
 
+	// Freeze the newly published version
+	if module.Version != nil && module.Version.IsCreated() {
+		err := r.insertVersion(ctx, module.Version)
+		if err != nil {
+			return err
+		}
+	}
+
 	// Handle questions cascade
 	for _, question := range module.Questions {
 		if question.IsCreated() {
-			// Insert new question
-			questionModel := model.Question{
-				ID:       util.ParseUUID(question.ID),
-				ModuleID: util.ParseUUID(question.ModuleID),
-				Content:  question.Content,
-				Slug:     question.Slug,
-			}
-
-			err := r.db.Model(&model.Question{}).WithContext(ctx).Create(&questionModel).Error
+			err := r.insertQuestion(ctx, question)
 			if err != nil {
 				return err
 			}
-
-			// Insert question choices
-			for _, choice := range question.Choices {
-				choiceModel := model.QuestionChoice{
-					ID:              util.ParseUUID(choice.ID),
-					QuestionID:      util.ParseUUID(choice.QuestionID),
-					Content:         choice.Content,
-					IsCorrectAnswer: choice.IsCorrectAnswer,
-				}
-
-				err := r.db.Model(&model.QuestionChoice{}).WithContext(ctx).Create(&choiceModel).Error
-				if err != nil {
-					return err
-				}
-			}
 		} else if question.IsUpdated() {
 			// Update existing question using map to handle zero values
 			updates := map[string]any{
-				"content": question.Content,
-				"slug":    question.Slug,
+				"type":                 model.QuestionType(question.Type),
+				"content":              question.Content,
+				"content_format":       model.ContentFormat(question.ContentFormat),
+				"rationale":            question.Rationale,
+				"tag":                  question.Tag,
+				"slug":                 question.Slug,
+				"position":             question.Position,
+				"points":               question.Points,
+				"case_sensitive":       question.CaseSensitive,
+				"diacritics_sensitive": question.DiacriticsSensitive,
+				"numeric_value":        null.FloatFromPtr(question.NumericValue),
+				"tolerance":            question.Tolerance,
+				"tolerance_type":       model.ToleranceType(question.ToleranceType),
+				"bank_question_id":     null.StringFromPtr(question.BankQuestionID),
 			}
 
 			err := r.db.Model(&model.Question{}).WithContext(ctx).Where("id = ?", question.ID).Updates(updates).Error
@@ -123,6 +147,9 @@ func (r *ModuleWriterRepository) update(ctx context.Context, module *entity.Modu
 						QuestionID:      util.ParseUUID(choice.QuestionID),
 						Content:         choice.Content,
 						IsCorrectAnswer: choice.IsCorrectAnswer,
+						Position:        choice.Position,
+						CorrectPosition: choice.CorrectPosition,
+						Explanation:     choice.Explanation,
 					}
 
 					err := r.db.Model(&model.QuestionChoice{}).WithContext(ctx).Create(&choiceModel).Error
@@ -134,6 +161,9 @@ func (r *ModuleWriterRepository) update(ctx context.Context, module *entity.Modu
 					updates := map[string]any{
 						"content":           choice.Content,
 						"is_correct_answer": choice.IsCorrectAnswer,
+						"position":          choice.Position,
+						"correct_position":  choice.CorrectPosition,
+						"explanation":       choice.Explanation,
 					}
 
 					err := r.db.Model(&model.QuestionChoice{}).WithContext(ctx).Where("id = ?", choice.ID).Updates(updates).Error
@@ -152,6 +182,113 @@ func (r *ModuleWriterRepository) update(ctx context.Context, module *entity.Modu
 					}
 				}
 			}
+
+			// Handle question pairs cascade
+			for _, pair := range question.Pairs {
+				if pair.IsCreated() {
+					err := r.insertPair(ctx, pair)
+					if err != nil {
+						return err
+					}
+				} else if pair.IsUpdated() {
+					// Update existing pair using map to handle zero values
+					updates := map[string]any{
+						"left_content":  pair.LeftContent,
+						"right_content": pair.RightContent,
+					}
+
+					err := r.db.Model(&model.QuestionPair{}).WithContext(ctx).Where("id = ?", pair.ID).Updates(updates).Error
+					if err != nil {
+						return err
+					}
+				} else if pair.IsRemoved() {
+					// Soft delete pair
+					pairModel := model.QuestionPair{
+						DeletedAt: null.TimeFrom(time.Now().UTC()),
+					}
+
+					err := r.db.Model(&model.QuestionPair{}).WithContext(ctx).Where("id = ?", pair.ID).Updates(&pairModel).Error
+					if err != nil {
+						return err
+					}
+				}
+			}
+
+			// Handle question accepted answers cascade
+			for _, answer := range question.AcceptedAnswers {
+				if answer.IsCreated() {
+					err := r.insertAcceptedAnswer(ctx, answer)
+					if err != nil {
+						return err
+					}
+				} else if answer.IsUpdated() {
+					// Update existing accepted answer using map to handle zero values
+					updates := map[string]any{
+						"content":  answer.Content,
+						"is_regex": answer.IsRegex,
+					}
+
+					err := r.db.Model(&model.QuestionAcceptedAnswer{}).WithContext(ctx).Where("id = ?", answer.ID).Updates(updates).Error
+					if err != nil {
+						return err
+					}
+				} else if answer.IsRemoved() {
+					// Soft delete accepted answer
+					answerModel := model.QuestionAcceptedAnswer{
+						DeletedAt: null.TimeFrom(time.Now().UTC()),
+					}
+
+					err := r.db.Model(&model.QuestionAcceptedAnswer{}).WithContext(ctx).Where("id = ?", answer.ID).Updates(&answerModel).Error
+					if err != nil {
+						return err
+					}
+				}
+			}
+
+			// Handle question units cascade
+			for _, unit := range question.Units {
+				if unit.IsCreated() {
+					err := r.insertUnit(ctx, unit)
+					if err != nil {
+						return err
+					}
+				} else if unit.IsUpdated() {
+					err := r.db.Model(&model.QuestionUnit{}).WithContext(ctx).Where("id = ?", unit.ID).Update("name", unit.Name).Error
+					if err != nil {
+						return err
+					}
+				} else if unit.IsRemoved() {
+					// Soft delete unit
+					unitModel := model.QuestionUnit{
+						DeletedAt: null.TimeFrom(time.Now().UTC()),
+					}
+
+					err := r.db.Model(&model.QuestionUnit{}).WithContext(ctx).Where("id = ?", unit.ID).Updates(&unitModel).Error
+					if err != nil {
+						return err
+					}
+				}
+			}
+
+			// Handle question attachments cascade
+			for _, attachment := range question.Attachments {
+				if attachment.IsCreated() {
+					err := r.insertAttachment(ctx, attachment)
+					if err != nil {
+						return err
+					}
+				} else if attachment.IsRemoved() {
+					// Soft delete attachment, the stored file stays for published versions
+					attachmentModel := model.QuestionAttachment{
+						DeletedAt: null.TimeFrom(time.Now().UTC()),
+					}
+
+					err := r.db.Model(&model.QuestionAttachment{}).WithContext(ctx).Where("id = ?", attachment.ID).Updates(&attachmentModel).Error
+					if err != nil {
+						return err
+					}
+				}
+			}
 		} else if question.IsRemoved() {
 			// Soft delete question
 			questionModel := model.Question{
@@ -170,12 +307,189 @@ func (r *ModuleWriterRepository) update(ctx context.Context, module *entity.Modu
 			if err != nil {
 				return err
 			}
+
+			// Soft delete all associated pairs
+			err = r.db.Model(&model.QuestionPair{}).WithContext(ctx).
+				Where("question_id = ?", question.ID).
+				Updates(model.QuestionPair{DeletedAt: null.TimeFrom(time.Now().UTC())}).Error
+			if err != nil {
+				return err
+			}
+
+			// Soft delete all associated accepted answers
+			err = r.db.Model(&model.QuestionAcceptedAnswer{}).WithContext(ctx).
+				Where("question_id = ?", question.ID).
+				Updates(model.QuestionAcceptedAnswer{DeletedAt: null.TimeFrom(time.Now().UTC())}).Error
+			if err != nil {
+				return err
+			}
+
+			// Soft delete all associated units
+			err = r.db.Model(&model.QuestionUnit{}).WithContext(ctx).
+				Where("question_id = ?", question.ID).
+				Updates(model.QuestionUnit{DeletedAt: null.TimeFrom(time.Now().UTC())}).Error
+			if err != nil {
+				return err
+			}
+
+			// Soft delete all associated attachments
+			err = r.db.Model(&model.QuestionAttachment{}).WithContext(ctx).
+				Where("question_id = ?", question.ID).
+				Updates(model.QuestionAttachment{DeletedAt: null.TimeFrom(time.Now().UTC())}).Error
+			if err != nil {
+				return err
+			}
 		}
 	}
 
 	return nil
 }
 
+func (r *ModuleWriterRepository) insertQuestion(ctx context.Context, question *entity.Question) error {
+	questionModel := model.Question{
+		ID:                  util.ParseUUID(question.ID),
+		ModuleID:            util.ParseUUID(question.ModuleID),
+		Type:                model.QuestionType(question.Type),
+		Content:             question.Content,
+		ContentFormat:       model.ContentFormat(question.ContentFormat),
+		Rationale:           question.Rationale,
+		Tag:                 question.Tag,
+		Slug:                question.Slug,
+		Position:            question.Position,
+		Points:              question.Points,
+		CaseSensitive:       question.CaseSensitive,
+		DiacriticsSensitive: question.DiacriticsSensitive,
+		NumericValue:        null.FloatFromPtr(question.NumericValue),
+		Tolerance:           question.Tolerance,
+		ToleranceType:       model.ToleranceType(question.ToleranceType),
+		BankQuestionID:      null.StringFromPtr(question.BankQuestionID),
+	}
+
+	err := r.db.Model(&model.Question{}).WithContext(ctx).Create(&questionModel).Error
+	if err != nil {
+		return err
+	}
+
+	// Insert question choices
+	for _, choice := range question.Choices {
+		choiceModel := model.QuestionChoice{
+			ID:              util.ParseUUID(choice.ID),
+			QuestionID:      util.ParseUUID(choice.QuestionID),
+			Content:         choice.Content,
+			IsCorrectAnswer: choice.IsCorrectAnswer,
+			Position:        choice.Position,
+			CorrectPosition: choice.CorrectPosition,
+			Explanation:     choice.Explanation,
+		}
+
+		err := r.db.Model(&model.QuestionChoice{}).WithContext(ctx).Create(&choiceModel).Error
+		if err != nil {
+			return err
+		}
+	}
+
+	// Insert question pairs
+	for _, pair := range question.Pairs {
+		err := r.insertPair(ctx, pair)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Insert question accepted answers
+	for _, answer := range question.AcceptedAnswers {
+		err := r.insertAcceptedAnswer(ctx, answer)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Insert question units
+	for _, unit := range question.Units {
+		err := r.insertUnit(ctx, unit)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Insert question attachments
+	for _, attachment := range question.Attachments {
+		err := r.insertAttachment(ctx, attachment)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *ModuleWriterRepository) insertVersion(ctx context.Context, version *entity.ModuleVersion) error {
+	questions := make(model.QuestionSnapshots, len(version.Questions))
+
+	for i, question := range version.Questions {
+		questions[i] = toQuestionSnapshot(question)
+	}
+
+	versionModel := model.ModuleVersion{
+		ID:                 util.ParseUUID(version.ID),
+		ModuleID:           util.ParseUUID(version.ModuleID),
+		Version:            version.Version,
+		WrongAnswerPenalty: version.WrongAnswerPenalty,
+		DrawCount:          version.DrawCount,
+		StratifyDraw:       version.StratifyDraw,
+		Questions:          questions,
+	}
+
+	return r.db.Model(&model.ModuleVersion{}).WithContext(ctx).Create(&versionModel).Error
+}
+
+func (r *ModuleWriterRepository) insertPair(ctx context.Context, pair *entity.QuestionPair) error {
+	pairModel := model.QuestionPair{
+		ID:           util.ParseUUID(pair.ID),
+		QuestionID:   util.ParseUUID(pair.QuestionID),
+		LeftContent:  pair.LeftContent,
+		RightID:      util.ParseUUID(pair.RightID),
+		RightContent: pair.RightContent,
+	}
+
+	return r.db.Model(&model.QuestionPair{}).WithContext(ctx).Create(&pairModel).Error
+}
+
+func (r *ModuleWriterRepository) insertAcceptedAnswer(ctx context.Context, answer *entity.QuestionAcceptedAnswer) error {
+	answerModel := model.QuestionAcceptedAnswer{
+		ID:         util.ParseUUID(answer.ID),
+		QuestionID: util.ParseUUID(answer.QuestionID),
+		Content:    answer.Content,
+		IsRegex:    answer.IsRegex,
+	}
+
+	return r.db.Model(&model.QuestionAcceptedAnswer{}).WithContext(ctx).Create(&answerModel).Error
+}
+
+func (r *ModuleWriterRepository) insertUnit(ctx context.Context, unit *entity.QuestionUnit) error {
+	unitModel := model.QuestionUnit{
+		ID:         util.ParseUUID(unit.ID),
+		QuestionID: util.ParseUUID(unit.QuestionID),
+		Name:       unit.Name,
+	}
+
+	return r.db.Model(&model.QuestionUnit{}).WithContext(ctx).Create(&unitModel).Error
+}
+
+func (r *ModuleWriterRepository) insertAttachment(ctx context.Context, attachment *entity.QuestionAttachment) error {
+	attachmentModel := model.QuestionAttachment{
+		ID:             util.ParseUUID(attachment.ID),
+		QuestionID:     util.ParseUUID(attachment.QuestionID),
+		ChoicePosition: attachment.ChoicePosition,
+		StorageKey:     attachment.StorageKey,
+		Filename:       attachment.Filename,
+		ContentType:    attachment.ContentType,
+		Size:           attachment.Size,
+	}
+
+	return r.db.Model(&model.QuestionAttachment{}).WithContext(ctx).Create(&attachmentModel).Error
+}
+
 func (r *ModuleWriterRepository) remove(ctx context.Context, module *entity.Module) error {
 	moduleModel := model.Module{
 		DeletedAt: null.TimeFrom(time.Now().UTC()),
@@ -188,3 +502,96 @@ func (r *ModuleWriterRepository) remove(ctx context.Context, module *entity.Modu
 
 	return nil
 }
+
+// toQuestionSnapshot copies a question and its live answers into the frozen version format
+func toQuestionSnapshot(question *entity.Question) *model.QuestionSnapshot {
+	snapshot := &model.QuestionSnapshot{
+		ID:                  question.ID,
+		Type:                model.QuestionType(question.Type),
+		Content:             question.Content,
+		ContentFormat:       model.ContentFormat(question.ContentFormat),
+		Rationale:           question.Rationale,
+		Tag:                 question.Tag,
+		Slug:                question.Slug,
+		Position:            question.Position,
+		Points:              question.Points,
+		CaseSensitive:       question.CaseSensitive,
+		DiacriticsSensitive: question.DiacriticsSensitive,
+		NumericValue:        question.NumericValue,
+		Tolerance:           question.Tolerance,
+		ToleranceType:       model.ToleranceType(question.ToleranceType),
+		Choices:             []*model.QuestionChoiceSnapshot{},
+		Pairs:               []*model.QuestionPairSnapshot{},
+		AcceptedAnswers:     []*model.QuestionAcceptedAnswerSnapshot{},
+		Units:               []*model.QuestionUnitSnapshot{},
+		Attachments:         []*model.QuestionAttachmentSnapshot{},
+	}
+
+	for _, choice := range question.Choices {
+		if choice.IsRemoved() {
+			continue
+		}
+
+		snapshot.Choices = append(snapshot.Choices, &model.QuestionChoiceSnapshot{
+			ID:              choice.ID,
+			Content:         choice.Content,
+			IsCorrectAnswer: choice.IsCorrectAnswer,
+			Position:        choice.Position,
+			CorrectPosition: choice.CorrectPosition,
+			Explanation:     choice.Explanation,
+		})
+	}
+
+	for _, pair := range question.Pairs {
+		if pair.IsRemoved() {
+			continue
+		}
+
+		snapshot.Pairs = append(snapshot.Pairs, &model.QuestionPairSnapshot{
+			ID:           pair.ID,
+			LeftContent:  pair.LeftContent,
+			RightID:      pair.RightID,
+			RightContent: pair.RightContent,
+		})
+	}
+
+	for _, answer := range question.AcceptedAnswers {
+		if answer.IsRemoved() {
+			continue
+		}
+
+		snapshot.AcceptedAnswers = append(snapshot.AcceptedAnswers, &model.QuestionAcceptedAnswerSnapshot{
+			ID:      answer.ID,
+			Content: answer.Content,
+			IsRegex: answer.IsRegex,
+		})
+	}
+
+	for _, unit := range question.Units {
+		if unit.IsRemoved() {
+			continue
+		}
+
+		snapshot.Units = append(snapshot.Units, &model.QuestionUnitSnapshot{
+			ID:   unit.ID,
+			Name: unit.Name,
+		})
+	}
+
+	for _, attachment := range question.Attachments {
+		if attachment.IsRemoved() {
+			continue
+		}
+
+		snapshot.Attachments = append(snapshot.Attachments, &model.QuestionAttachmentSnapshot{
+			ID:             attachment.ID,
+			ChoicePosition: attachment.ChoicePosition,
+			StorageKey:     attachment.StorageKey,
+			Filename:       attachment.Filename,
+			ContentType:    attachment.ContentType,
+			Size:           attachment.Size,
+		})
+	}
+
+	return snapshot
+}
@@ -0,0 +1,51 @@
+package module
+
+import (
+	"context"
+
+	"github.com/arvinpaundra/private-api/domain/module/entity"
+	"github.com/arvinpaundra/private-api/domain/module/repository"
+	"gorm.io/gorm"
+)
+
+var _ repository.ModuleReader = (*PreviewReaderRepository)(nil)
+
+// PreviewReaderRepository serves a teacher their own modules as if they were published, the
+// draft standing in for the published version, so a module can be walked through before publishing
+type PreviewReaderRepository struct {
+	*ModuleReaderRepository
+
+	userID string
+}
+
+func NewPreviewReaderRepository(db *gorm.DB, userID string) *PreviewReaderRepository {
+	return &PreviewReaderRepository{
+		ModuleReaderRepository: NewModuleReaderRepository(db),
+		userID:                 userID,
+	}
+}
+
+// FindPublishedModuleBySlug returns the user's module whether it is published or not, and
+// whatever its schedule. Its published version is 0, the draft.
+func (r *PreviewReaderRepository) FindPublishedModuleBySlug(ctx context.Context, slug string) (*entity.Module, error) {
+	module, err := r.FindBySlug(ctx, slug, r.userID)
+	if err != nil {
+		return nil, err
+	}
+
+	module.PublishedVersion = 0
+	module.OpensAt = nil
+	module.ClosesAt = nil
+
+	return module, nil
+}
+
+// FindPublishedVersion freezes the draft questions into version 0, whatever version is asked for
+func (r *PreviewReaderRepository) FindPublishedVersion(ctx context.Context, moduleSlug string, _ int) (*entity.ModuleVersion, error) {
+	module, err := r.FindModuleDetailBySlug(ctx, moduleSlug, r.userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return entity.NewModuleVersion(module.ID, 0, module.Settings(), module.Questions), nil
+}
@@ -71,3 +71,20 @@ func (a *SubjectACLAdapter) GetSubjectNames(ctx context.Context, subjectIDs []st
 
 	return names, nil
 }
+
+func (a *SubjectACLAdapter) FindOrCreateSubject(ctx context.Context, name string, userID string) (string, error) {
+	subjectService := service.NewFindOrCreateSubject(
+		a.authStorage,
+		subject.NewSubjectReaderRepository(a.db),
+		subject.NewSubjectWriterRepository(a.db),
+	)
+
+	subjectID, err := subjectService.Execute(ctx, &service.FindOrCreateSubjectCommand{
+		Name: name,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return subjectID, nil
+}
@@ -0,0 +1,56 @@
+package module
+
+import (
+	"context"
+	"errors"
+
+	"github.com/arvinpaundra/private-api/domain/module/constant"
+	"github.com/arvinpaundra/private-api/domain/module/entity"
+	"github.com/arvinpaundra/private-api/domain/module/repository"
+	"github.com/arvinpaundra/private-api/model"
+	"gorm.io/gorm"
+)
+
+var _ repository.SubmissionACL = (*SubmissionACLAdapter)(nil)
+
+// SubmissionACLAdapter reads submissions straight from storage instead of going through the submission
+// services: infrastructure/submission imports this package and the module services to serve and grade
+// questions, so importing it back here would be an import cycle
+type SubmissionACLAdapter struct {
+	db *gorm.DB
+}
+
+func NewSubmissionACLAdapter(db *gorm.DB) *SubmissionACLAdapter {
+	return &SubmissionACLAdapter{
+		db: db,
+	}
+}
+
+func (a *SubmissionACLAdapter) GetSubmission(ctx context.Context, moduleID, submissionCode string) (*entity.Submission, error) {
+	var submission model.Submission
+
+	err := a.db.Model(&model.Submission{}).
+		WithContext(ctx).
+		Select("id", "code", "status", "module_version", "shuffle_choices", "question_seed", "question_ids", "expires_at").
+		Where("module_id = ?", moduleID).
+		Where("code = ?", submissionCode).
+		First(&submission).
+		Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, constant.ErrSubmissionNotFound
+		}
+		return nil, err
+	}
+
+	return &entity.Submission{
+		Code:           submission.Code,
+		Status:         constant.SubmissionStatus(submission.Status),
+		ModuleVersion:  submission.ModuleVersion,
+		ShuffleChoices: submission.ShuffleChoices,
+		QuestionSeed:   submission.QuestionSeed,
+		QuestionIDs:    submission.QuestionIDs,
+		ExpiresAt:      submission.ExpiresAt.Ptr(),
+	}, nil
+}
@@ -108,6 +108,36 @@ func (r *SubjectReaderRepository) FindSubjectByID(ctx context.Context, subjectID
 	return subject, nil
 }
 
+// FindSubjectByName matches the name case-insensitively, the same way duplicate names are detected
+func (r *SubjectReaderRepository) FindSubjectByName(ctx context.Context, name string, userID string) (*entity.Subject, error) {
+	var subjectModel model.Subject
+
+	err := r.db.Model(&model.Subject{}).
+		WithContext(ctx).
+		Select("id", "name", "description").
+		Where("LOWER(name) = ?", strings.ToLower(name)).
+		Where("user_id = ?", userID).
+		Where("deleted_at IS NULL").
+		First(&subjectModel).
+		Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, constant.ErrSubjectNotFound
+		}
+
+		return nil, err
+	}
+
+	subject := &entity.Subject{
+		ID:          subjectModel.ID.String(),
+		Name:        subjectModel.Name,
+		Description: subjectModel.Description.Ptr(),
+	}
+
+	return subject, nil
+}
+
 func (r *SubjectReaderRepository) AllSubjects(ctx context.Context, userID string, keyword string) ([]*entity.Subject, error) {
 	var subjectModels []model.Subject
 
@@ -3,7 +3,10 @@ package submission
 import (
 	"context"
 	"strings"
+	"time"
 
+	"github.com/arvinpaundra/private-api/core/storage"
+	modulerepository "github.com/arvinpaundra/private-api/domain/module/repository"
 	"github.com/arvinpaundra/private-api/domain/module/service"
 	"github.com/arvinpaundra/private-api/domain/submission/constant"
 	"github.com/arvinpaundra/private-api/domain/submission/entity"
@@ -16,56 +19,106 @@ import (
 var _ repository.ModuleACL = (*ModuleACLAdapter)(nil)
 
 type ModuleACLAdapter struct {
-	db *gorm.DB
+	db           *gorm.DB
+	fileStorage  storage.Storage
+	moduleReader modulerepository.ModuleReader
+
+	// preview serves the user's draft instead of the published version
+	preview bool
+}
+
+func NewModuleACLAdapter(db *gorm.DB, fileStorage storage.Storage) *ModuleACLAdapter {
+	return &ModuleACLAdapter{
+		db:           db,
+		fileStorage:  fileStorage,
+		moduleReader: module.NewModuleReaderRepository(db),
+	}
 }
 
-func NewModuleACLAdapter(db *gorm.DB) *ModuleACLAdapter {
+// NewPreviewModuleACLAdapter serves the user's own modules, published or not, from their draft
+func NewPreviewModuleACLAdapter(db *gorm.DB, fileStorage storage.Storage, userID string) *ModuleACLAdapter {
 	return &ModuleACLAdapter{
-		db: db,
+		db:           db,
+		fileStorage:  fileStorage,
+		moduleReader: module.NewPreviewReaderRepository(db, userID),
+		preview:      true,
 	}
 }
 
-func (a *ModuleACLAdapter) GetCorrectAnswer(ctx context.Context, moduleSlug, questionSlug string) (*entity.Choice, error) {
-	svc := service.NewGetCorrectAnswer(
-		module.NewModuleReaderRepository(a.db),
+func (a *ModuleACLAdapter) GetAnswerKey(ctx context.Context, moduleSlug string, version int, questionSlug, submissionCode string) (*entity.AnswerKey, error) {
+	svc := service.NewGetAnswerKey(
+		a.moduleReader,
+		module.NewSubmissionACLAdapter(a.db),
 	)
 
-	correctChoice, err := svc.Execute(ctx, &service.GetCorrectAnswerCommand{
-		ModuleSlug:   moduleSlug,
-		QuestionSlug: questionSlug,
+	key, err := svc.Execute(ctx, &service.GetAnswerKeyCommand{
+		ModuleSlug:     moduleSlug,
+		QuestionSlug:   questionSlug,
+		Version:        version,
+		SubmissionCode: submissionCode,
 	})
 	if err != nil {
 		return nil, err
 	}
 
 	// Map to submission domain entity
-	return &entity.Choice{
-		ID:              correctChoice.ID,
-		Content:         correctChoice.Content,
-		IsCorrectAnswer: correctChoice.IsCorrectAnswer,
-	}, nil
-}
+	answerKey := &entity.AnswerKey{
+		Type:               constant.QuestionType(key.Type),
+		Points:             key.Points,
+		WrongAnswerPenalty: key.WrongAnswerPenalty,
+		Rationale:          key.Rationale,
+	}
 
-func (a *ModuleACLAdapter) GetNextQuestionSlug(ctx context.Context, moduleSlug, currentQuestionSlug string) (*string, error) {
-	svc := service.NewFindPublishedQuestion(
-		module.NewModuleReaderRepository(a.db),
-	)
+	for _, choice := range key.Choices {
+		answerKey.Choices = append(answerKey.Choices, &entity.Choice{
+			ID:              choice.ID,
+			Content:         choice.Content,
+			IsCorrectAnswer: choice.IsCorrectAnswer,
+			CorrectPosition: choice.CorrectPosition,
+			Explanation:     choice.Explanation,
+		})
+	}
 
-	questionDetail, err := svc.Execute(ctx, &service.FindPublishedQuestionCommand{
-		ModuleSlug:   moduleSlug,
-		QuestionSlug: currentQuestionSlug,
-	})
-	if err != nil {
-		return nil, err
+	for _, pair := range key.Pairs {
+		answerKey.Pairs = append(answerKey.Pairs, &entity.Pair{
+			ID:           pair.ID,
+			LeftContent:  pair.LeftContent,
+			RightID:      pair.RightID,
+			RightContent: pair.RightContent,
+		})
+	}
+
+	if key.Text != nil {
+		acceptedAnswers := make([]*entity.AcceptedAnswer, len(key.Text.AcceptedAnswers))
+		for i, answer := range key.Text.AcceptedAnswers {
+			acceptedAnswers[i] = &entity.AcceptedAnswer{
+				Content: answer.Content,
+				IsRegex: answer.IsRegex,
+			}
+		}
+
+		answerKey.Text = &entity.TextAnswerKey{
+			CaseSensitive:       key.Text.CaseSensitive,
+			DiacriticsSensitive: key.Text.DiacriticsSensitive,
+			AcceptedAnswers:     acceptedAnswers,
+		}
 	}
 
-	// Return next question slug
-	return questionDetail.NextQuestionSlug, nil
+	if key.Numeric != nil {
+		answerKey.Numeric = &entity.NumericAnswerKey{
+			Value:         key.Numeric.Value,
+			Tolerance:     key.Numeric.Tolerance,
+			ToleranceType: constant.ToleranceType(key.Numeric.ToleranceType),
+			Units:         key.Numeric.Units,
+		}
+	}
+
+	return answerKey, nil
 }
 
 func (a *ModuleACLAdapter) GetPublishedModule(ctx context.Context, moduleSlug string) (*entity.Module, error) {
 	svc := service.NewValidatePublishedModule(
-		module.NewModuleReaderRepository(a.db),
+		a.moduleReader,
 	)
 
 	module, err := svc.Execute(ctx, &service.ValidatePublishedModuleCommand{
@@ -79,26 +132,44 @@ func (a *ModuleACLAdapter) GetPublishedModule(ctx context.Context, moduleSlug st
 	}
 
 	return &entity.Module{
-		ID:   module.ID,
-		Slug: module.Slug,
+		ID:               module.ID,
+		Slug:             module.Slug,
+		Type:             constant.ModuleType(module.Type),
+		ScoringPolicy:    constant.ScoringPolicy(module.ScoringPolicy),
+		ShuffleQuestions: module.ShuffleQuestions,
+		ShuffleChoices:   module.ShuffleChoices,
+		Version:          module.PublishedVersion,
+		IsPreview:        a.preview,
+		TimeLimitMinutes: module.TimeLimitMinutes,
+		OpensAt:          module.OpensAt,
+		ClosesAt:         module.ClosesAt,
 	}, nil
 }
 
-func (a *ModuleACLAdapter) GetQuestionBySlug(ctx context.Context, moduleSlug, questionSlug string) (*entity.Question, error) {
+func (a *ModuleACLAdapter) GetQuestionBySlug(ctx context.Context, moduleSlug string, version int, questionSlug, submissionCode string) (*entity.Question, error) {
 	// Use module domain service to get question details
 	moduleService := service.NewFindPublishedQuestion(
-		module.NewModuleReaderRepository(a.db),
+		a.moduleReader,
+		module.NewSubmissionACLAdapter(a.db),
+		a.fileStorage,
 	)
 
+	// The submission code keeps answers to the questions it drew
 	questionDetail, err := moduleService.Execute(ctx, &service.FindPublishedQuestionCommand{
-		ModuleSlug:   moduleSlug,
-		QuestionSlug: questionSlug,
+		ModuleSlug:     moduleSlug,
+		QuestionSlug:   questionSlug,
+		Version:        version,
+		SubmissionCode: submissionCode,
 	})
 	if err != nil {
 		if strings.Contains(err.Error(), constant.ErrModuleNotFound.Error()) {
 			return nil, constant.ErrModuleNotFound
 		} else if strings.Contains(err.Error(), constant.ErrQuestionNotFound.Error()) {
 			return nil, constant.ErrQuestionNotFound
+		} else if strings.Contains(err.Error(), constant.ErrSubmissionExpired.Error()) {
+			return nil, constant.ErrSubmissionExpired
+		} else if strings.Contains(err.Error(), constant.ErrSubmissionAlreadyDone.Error()) {
+			return nil, constant.ErrSubmissionAlreadyDone
 		}
 		return nil, err
 	}
@@ -112,29 +183,54 @@ func (a *ModuleACLAdapter) GetQuestionBySlug(ctx context.Context, moduleSlug, qu
 		}
 	}
 
+	// Map matching items to submission domain
+	leftItems := make([]*entity.MatchingItem, len(questionDetail.LeftItems))
+	for i, item := range questionDetail.LeftItems {
+		leftItems[i] = &entity.MatchingItem{
+			ID:      item.ID,
+			Content: item.Content,
+		}
+	}
+
+	rightItems := make([]*entity.MatchingItem, len(questionDetail.RightItems))
+	for i, item := range questionDetail.RightItems {
+		rightItems[i] = &entity.MatchingItem{
+			ID:      item.ID,
+			Content: item.Content,
+		}
+	}
+
 	// Map to submission domain entity
 	return &entity.Question{
-		ID:               questionDetail.ID,
-		Content:          questionDetail.Content,
-		Slug:             questionDetail.Slug,
-		NextQuestionSlug: questionDetail.NextQuestionSlug,
-		Choices:          choices,
+		ID:         questionDetail.ID,
+		Type:       constant.QuestionType(questionDetail.Type),
+		Content:    questionDetail.Content,
+		Slug:       questionDetail.Slug,
+		Choices:    choices,
+		LeftItems:  leftItems,
+		RightItems: rightItems,
+		Units:      questionDetail.Units,
 	}, nil
 }
 
-func (a *ModuleACLAdapter) GetTotalQuestions(ctx context.Context, moduleSlug string) (int, error) {
-	svc := service.NewCountModuleQuestions(
-		module.NewModuleReaderRepository(a.db),
+func (a *ModuleACLAdapter) DrawQuestions(ctx context.Context, moduleSlug string, version int) (*entity.QuestionDraw, error) {
+	svc := service.NewDrawQuestions(
+		a.moduleReader,
 	)
 
-	total, err := svc.Execute(ctx, &service.CountModuleQuestionsCommand{
+	draw, err := svc.Execute(ctx, &service.DrawQuestionsCommand{
 		ModuleSlug: moduleSlug,
+		Version:    version,
 	})
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 
-	return total, nil
+	return &entity.QuestionDraw{
+		QuestionIDs:    draw.QuestionIDs,
+		TotalQuestions: draw.TotalQuestions,
+		TotalPoints:    draw.TotalPoints,
+	}, nil
 }
 
 func (a *ModuleACLAdapter) GetAllPublishedModules(ctx context.Context, keyword string) ([]*entity.Module, error) {
@@ -181,27 +277,42 @@ func (a *ModuleACLAdapter) GetAllPublishedModules(ctx context.Context, keyword s
 	return modules, nil
 }
 
-func (a *ModuleACLAdapter) GetFirstQuestionSlug(ctx context.Context, moduleSlug string) (*string, error) {
-	var question model.Question
+func (a *ModuleACLAdapter) GetQuestionOrder(ctx context.Context, moduleSlug string, version int, seed int64, questionIDs []string) ([]string, error) {
+	svc := service.NewFindQuestionOrder(
+		a.moduleReader,
+	)
+
+	return svc.Execute(ctx, &service.FindQuestionOrderCommand{
+		ModuleSlug:  moduleSlug,
+		Version:     version,
+		Seed:        seed,
+		QuestionIDs: questionIDs,
+	})
+}
+
+func (a *ModuleACLAdapter) GetClosedModules(ctx context.Context, now time.Time) ([]*entity.Module, error) {
+	var moduleModels []model.Module
 
-	err := a.db.Model(&model.Question{}).
+	err := a.db.Model(&model.Module{}).
 		WithContext(ctx).
-		Select("questions.slug").
-		Joins("JOIN modules ON modules.id = questions.module_id").
-		Where("modules.slug = ?", moduleSlug).
-		Where("modules.is_published = true").
-		Where("modules.deleted_at IS NULL").
-		Where("questions.deleted_at IS NULL").
-		Order("questions.created_at ASC").
-		First(&question).
+		Select("id", "closes_at").
+		Where("closes_at <= ?", now).
+		Where("deleted_at IS NULL").
+		Find(&moduleModels).
 		Error
 
 	if err != nil {
-		if err == gorm.ErrRecordNotFound {
-			return nil, nil
-		}
 		return nil, err
 	}
 
-	return &question.Slug, nil
+	modules := make([]*entity.Module, len(moduleModels))
+
+	for i, moduleModel := range moduleModels {
+		modules[i] = &entity.Module{
+			ID:       moduleModel.ID.String(),
+			ClosesAt: moduleModel.ClosesAt.Ptr(),
+		}
+	}
+
+	return modules, nil
 }
@@ -52,6 +52,13 @@ func (r *SubmissionReaderRepository) FindByCode(ctx context.Context, code string
 		StudentName:    submissionModel.StudentName,
 		Status:         constant.SubmissionStatus(submissionModel.Status),
 		TotalQuestions: submissionModel.TotalQuestions,
+		MaxPoints:      submissionModel.MaxPoints,
+		ModuleVersion:  submissionModel.ModuleVersion,
+		QuestionSeed:   submissionModel.QuestionSeed,
+		ShuffleChoices: submissionModel.ShuffleChoices,
+		QuestionIDs:    submissionModel.QuestionIDs,
+		IsPreview:      submissionModel.IsPreview,
+		ExpiresAt:      submissionModel.ExpiresAt.Ptr(),
 		SubmittedAt:    submittedAt,
 		Answers:        make([]*entity.SubmissionAnswer, len(submissionModel.Answers)),
 	}
@@ -63,7 +70,8 @@ func (r *SubmissionReaderRepository) FindByCode(ctx context.Context, code string
 			QuestionSlug: answerModel.QuestionSlug,
 			Question:     answerModel.Question,
 			Answer:       answerModel.Answer,
-			IsCorrect:    answerModel.IsCorrect,
+			Points:       answerModel.Points,
+			MaxPoints:    answerModel.MaxPoints,
 		}
 	}
 
@@ -75,6 +83,7 @@ func (r *SubmissionReaderRepository) TotalSubmissions(ctx context.Context, modul
 
 	query := r.db.Model(&model.Submission{}).
 		WithContext(ctx).
+		Where("is_preview = false").
 		Scopes(func(db *gorm.DB) *gorm.DB {
 			if moduleID != "" {
 				db.Where("module_id = ?", moduleID)
@@ -103,6 +112,7 @@ func (r *SubmissionReaderRepository) FindAllSubmissions(ctx context.Context, mod
 	query := r.db.Model(&model.Submission{}).
 		WithContext(ctx).
 		Preload("Answers").
+		Where("is_preview = false").
 		Scopes(func(db *gorm.DB) *gorm.DB {
 			if moduleID != "" {
 				db.Where("module_id = ?", moduleID)
@@ -144,6 +154,7 @@ func (r *SubmissionReaderRepository) FindAllSubmissions(ctx context.Context, mod
 			StudentName:    submissionModel.StudentName,
 			Status:         constant.SubmissionStatus(submissionModel.Status),
 			TotalQuestions: submissionModel.TotalQuestions,
+			MaxPoints:      submissionModel.MaxPoints,
 			SubmittedAt:    submittedAt,
 			Answers:        make([]*entity.SubmissionAnswer, len(submissionModel.Answers)),
 		}
@@ -155,7 +166,8 @@ func (r *SubmissionReaderRepository) FindAllSubmissions(ctx context.Context, mod
 				QuestionSlug: answerModel.QuestionSlug,
 				Question:     answerModel.Question,
 				Answer:       answerModel.Answer,
-				IsCorrect:    answerModel.IsCorrect,
+				Points:       answerModel.Points,
+				MaxPoints:    answerModel.MaxPoints,
 			}
 		}
 
@@ -176,7 +188,8 @@ func (r *SubmissionReaderRepository) FindAllSubmittedGroupedByModule(ctx context
 		WithContext(ctx).
 		Preload("Answers").
 		Where("module_id IN ?", moduleIDs).
-		Where("status = ?", constant.Submitted).
+		Where("status IN ?", []constant.SubmissionStatus{constant.Submitted, constant.Expired}).
+		Where("is_preview = false").
 		Order("module_id, submitted_at DESC").
 		Find(&submissionModels).
 		Error
@@ -201,6 +214,7 @@ func (r *SubmissionReaderRepository) FindAllSubmittedGroupedByModule(ctx context
 			StudentName:    submissionModel.StudentName,
 			Status:         constant.SubmissionStatus(submissionModel.Status),
 			TotalQuestions: submissionModel.TotalQuestions,
+			MaxPoints:      submissionModel.MaxPoints,
 			SubmittedAt:    submittedAt,
 			Answers:        make([]*entity.SubmissionAnswer, len(submissionModel.Answers)),
 		}
@@ -213,7 +227,8 @@ func (r *SubmissionReaderRepository) FindAllSubmittedGroupedByModule(ctx context
 				QuestionSlug: answerModel.QuestionSlug,
 				Question:     answerModel.Question,
 				Answer:       answerModel.Answer,
-				IsCorrect:    answerModel.IsCorrect,
+				Points:       answerModel.Points,
+				MaxPoints:    answerModel.MaxPoints,
 			}
 		}
 
@@ -229,7 +244,8 @@ func (r *SubmissionReaderRepository) CountSubmitted(ctx context.Context) (int, e
 
 	err := r.db.Model(&model.Submission{}).
 		WithContext(ctx).
-		Where("status = ?", model.Submitted).
+		Where("status IN ?", []model.SubmissionStatus{model.Submitted, model.Expired}).
+		Where("is_preview = false").
 		Count(&count).
 		Error
 
@@ -239,3 +255,76 @@ func (r *SubmissionReaderRepository) CountSubmitted(ctx context.Context) (int, e
 
 	return int(count), nil
 }
+
+// FindAllInProgress loads the student submissions of the modules still in progress, without their answers
+func (r *SubmissionReaderRepository) FindAllInProgress(ctx context.Context, moduleIDs []string) ([]*entity.Submission, error) {
+	if len(moduleIDs) == 0 {
+		return nil, nil
+	}
+
+	var submissionModels []model.Submission
+
+	err := r.db.Model(&model.Submission{}).
+		WithContext(ctx).
+		Where("module_id IN ?", moduleIDs).
+		Where("status = ?", constant.InProgress).
+		Where("is_preview = false").
+		Find(&submissionModels).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	submissions := make([]*entity.Submission, len(submissionModels))
+
+	for i, submissionModel := range submissionModels {
+		submissions[i] = &entity.Submission{
+			ID:             submissionModel.ID.String(),
+			ModuleID:       submissionModel.ModuleID.String(),
+			Code:           submissionModel.Code,
+			StudentName:    submissionModel.StudentName,
+			Status:         constant.SubmissionStatus(submissionModel.Status),
+			TotalQuestions: submissionModel.TotalQuestions,
+			MaxPoints:      submissionModel.MaxPoints,
+			ModuleVersion:  submissionModel.ModuleVersion,
+			ExpiresAt:      submissionModel.ExpiresAt.Ptr(),
+		}
+	}
+
+	return submissions, nil
+}
+
+// FindAllOverdue loads the submissions still in progress past their deadline, without their answers
+func (r *SubmissionReaderRepository) FindAllOverdue(ctx context.Context, now time.Time) ([]*entity.Submission, error) {
+	var submissionModels []model.Submission
+
+	err := r.db.Model(&model.Submission{}).
+		WithContext(ctx).
+		Where("status = ?", constant.InProgress).
+		Where("expires_at <= ?", now).
+		Find(&submissionModels).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	submissions := make([]*entity.Submission, len(submissionModels))
+
+	for i, submissionModel := range submissionModels {
+		submissions[i] = &entity.Submission{
+			ID:             submissionModel.ID.String(),
+			ModuleID:       submissionModel.ModuleID.String(),
+			Code:           submissionModel.Code,
+			StudentName:    submissionModel.StudentName,
+			Status:         constant.SubmissionStatus(submissionModel.Status),
+			TotalQuestions: submissionModel.TotalQuestions,
+			MaxPoints:      submissionModel.MaxPoints,
+			ModuleVersion:  submissionModel.ModuleVersion,
+			ExpiresAt:      submissionModel.ExpiresAt.Ptr(),
+		}
+	}
+
+	return submissions, nil
+}
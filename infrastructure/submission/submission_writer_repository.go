@@ -40,6 +40,13 @@ func (r *SubmissionWriterRepository) insert(ctx context.Context, submission *ent
 		StudentName:    submission.StudentName,
 		Status:         model.SubmissionStatus(submission.Status),
 		TotalQuestions: submission.TotalQuestions,
+		MaxPoints:      submission.MaxPoints,
+		ModuleVersion:  submission.ModuleVersion,
+		QuestionSeed:   submission.QuestionSeed,
+		ShuffleChoices: submission.ShuffleChoices,
+		QuestionIDs:    submission.QuestionIDs,
+		IsPreview:      submission.IsPreview,
+		ExpiresAt:      null.TimeFromPtr(submission.ExpiresAt),
 		SubmittedAt:    null.TimeFromPtr(submission.SubmittedAt),
 	}
 
@@ -79,19 +86,36 @@ func (r *SubmissionWriterRepository) update(ctx context.Context, submission *ent
 				QuestionSlug: answer.QuestionSlug,
 				Question:     answer.Question,
 				Answer:       answer.Answer,
-				IsCorrect:    answer.IsCorrect,
+				Points:       answer.Points,
+				MaxPoints:    answer.MaxPoints,
 			}
 
 			err := r.db.Model(&model.SubmissionAnswer{}).WithContext(ctx).Create(&answerModel).Error
 			if err != nil {
 				return err
 			}
+
+			for _, pair := range answer.Pairs {
+				pairModel := model.SubmissionAnswerPair{
+					ID:                 util.ParseUUID(pair.ID),
+					SubmissionAnswerID: util.ParseUUID(pair.SubmissionAnswerID),
+					LeftContent:        pair.LeftContent,
+					RightContent:       pair.RightContent,
+					IsCorrect:          pair.IsCorrect,
+				}
+
+				err := r.db.Model(&model.SubmissionAnswerPair{}).WithContext(ctx).Create(&pairModel).Error
+				if err != nil {
+					return err
+				}
+			}
 		} else if answer.IsUpdated() {
 			// Update existing answer
 			answerUpdates := map[string]any{
 				"question":   answer.Question,
 				"answer":     answer.Answer,
-				"is_correct": answer.IsCorrect,
+				"points":     answer.Points,
+				"max_points": answer.MaxPoints,
 			}
 
 			err := r.db.Model(&model.SubmissionAnswer{}).
@@ -118,3 +142,37 @@ func (r *SubmissionWriterRepository) update(ctx context.Context, submission *ent
 
 	return nil
 }
+
+func (r *SubmissionWriterRepository) RemovePreviews(ctx context.Context, moduleID string) error {
+	previews := r.db.Model(&model.Submission{}).
+		Select("id").
+		Where("module_id = ?", moduleID).
+		Where("is_preview = true")
+
+	answers := r.db.Model(&model.SubmissionAnswer{}).
+		Select("id").
+		Where("submission_id IN (?)", previews)
+
+	// Previews are not kept, delete them outright, children first
+	err := r.db.WithContext(ctx).
+		Where("submission_answer_id IN (?)", answers).
+		Delete(&model.SubmissionAnswerPair{}).
+		Error
+	if err != nil {
+		return err
+	}
+
+	err = r.db.WithContext(ctx).
+		Where("submission_id IN (?)", previews).
+		Delete(&model.SubmissionAnswer{}).
+		Error
+	if err != nil {
+		return err
+	}
+
+	return r.db.WithContext(ctx).
+		Where("module_id = ?", moduleID).
+		Where("is_preview = true").
+		Delete(&model.Submission{}).
+		Error
+}
@@ -0,0 +1,8 @@
+BEGIN;
+
+ALTER TABLE submission_answers ALTER COLUMN answer TYPE VARCHAR(255) USING LEFT(answer, 255);
+
+DROP TABLE IF EXISTS submission_answer_pairs;
+DROP TABLE IF EXISTS question_pairs;
+
+COMMIT;
\ No newline at end of file
@@ -0,0 +1,28 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS question_pairs (
+    id UUID PRIMARY KEY,
+    question_id UUID NOT NULL,
+    left_content VARCHAR(255) NOT NULL,
+    right_id UUID NOT NULL UNIQUE,
+    right_content VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP,
+    FOREIGN KEY (question_id) REFERENCES questions(id)
+);
+
+CREATE TABLE IF NOT EXISTS submission_answer_pairs (
+    id UUID PRIMARY KEY,
+    submission_answer_id UUID NOT NULL,
+    left_content VARCHAR(255) NOT NULL,
+    right_content VARCHAR(255) NOT NULL,
+    is_correct BOOLEAN NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (submission_answer_id) REFERENCES submission_answers(id)
+);
+
+ALTER TABLE submission_answers ALTER COLUMN answer TYPE TEXT;
+
+COMMIT;
\ No newline at end of file
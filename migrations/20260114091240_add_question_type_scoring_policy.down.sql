@@ -0,0 +1,15 @@
+BEGIN;
+
+ALTER TABLE submission_answers ADD COLUMN is_correct BOOLEAN NOT NULL DEFAULT FALSE;
+
+UPDATE submission_answers SET is_correct = points >= 1;
+
+ALTER TABLE submission_answers DROP COLUMN points;
+
+ALTER TABLE questions DROP COLUMN type;
+ALTER TABLE modules DROP COLUMN scoring_policy;
+
+DROP TYPE IF EXISTS scoring_policy;
+DROP TYPE IF EXISTS question_type;
+
+COMMIT;
\ No newline at end of file
@@ -0,0 +1,22 @@
+BEGIN;
+
+CREATE TYPE question_type AS ENUM ('single_choice', 'multiple_select', 'matching');
+CREATE TYPE scoring_policy AS ENUM ('all_or_nothing', 'proportional', 'right_minus_wrong');
+
+ALTER TABLE modules ADD COLUMN scoring_policy scoring_policy NOT NULL DEFAULT 'all_or_nothing';
+
+ALTER TABLE questions ADD COLUMN type question_type NOT NULL DEFAULT 'single_choice';
+
+UPDATE questions
+SET type = 'matching'
+FROM modules
+WHERE modules.id = questions.module_id
+  AND modules.type = 'matching_type';
+
+ALTER TABLE submission_answers ADD COLUMN points NUMERIC(5, 4) NOT NULL DEFAULT 0;
+
+UPDATE submission_answers SET points = CASE WHEN is_correct THEN 1 ELSE 0 END;
+
+ALTER TABLE submission_answers DROP COLUMN is_correct;
+
+COMMIT;
\ No newline at end of file
@@ -0,0 +1,11 @@
+BEGIN;
+
+DROP TABLE IF EXISTS question_accepted_answers;
+
+ALTER TABLE questions DROP COLUMN diacritics_sensitive;
+ALTER TABLE questions DROP COLUMN case_sensitive;
+
+-- enum values cannot be dropped, so short answer questions fall back to single choice
+UPDATE questions SET type = 'single_choice' WHERE type = 'short_answer';
+
+COMMIT;
\ No newline at end of file
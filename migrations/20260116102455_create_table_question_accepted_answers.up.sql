@@ -0,0 +1,19 @@
+BEGIN;
+
+ALTER TYPE question_type ADD VALUE IF NOT EXISTS 'short_answer';
+
+ALTER TABLE questions ADD COLUMN case_sensitive BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE questions ADD COLUMN diacritics_sensitive BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE TABLE IF NOT EXISTS question_accepted_answers (
+    id UUID PRIMARY KEY,
+    question_id UUID NOT NULL,
+    content VARCHAR(255) NOT NULL,
+    is_regex BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP,
+    FOREIGN KEY (question_id) REFERENCES questions(id)
+);
+
+COMMIT;
\ No newline at end of file
@@ -0,0 +1,14 @@
+BEGIN;
+
+DROP TABLE IF EXISTS question_units;
+
+ALTER TABLE questions DROP COLUMN tolerance_type;
+ALTER TABLE questions DROP COLUMN tolerance;
+ALTER TABLE questions DROP COLUMN numeric_value;
+
+DROP TYPE IF EXISTS tolerance_type;
+
+-- enum values cannot be dropped, so numeric questions fall back to single choice
+UPDATE questions SET type = 'single_choice' WHERE type = 'numeric';
+
+COMMIT;
\ No newline at end of file
@@ -0,0 +1,21 @@
+BEGIN;
+
+ALTER TYPE question_type ADD VALUE IF NOT EXISTS 'numeric';
+
+CREATE TYPE tolerance_type AS ENUM ('absolute', 'percentage');
+
+ALTER TABLE questions ADD COLUMN numeric_value NUMERIC;
+ALTER TABLE questions ADD COLUMN tolerance NUMERIC NOT NULL DEFAULT 0;
+ALTER TABLE questions ADD COLUMN tolerance_type tolerance_type NOT NULL DEFAULT 'absolute';
+
+CREATE TABLE IF NOT EXISTS question_units (
+    id UUID PRIMARY KEY,
+    question_id UUID NOT NULL,
+    name VARCHAR(50) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP,
+    FOREIGN KEY (question_id) REFERENCES questions(id)
+);
+
+COMMIT;
\ No newline at end of file
@@ -0,0 +1,9 @@
+BEGIN;
+
+ALTER TABLE question_choices DROP COLUMN correct_position;
+ALTER TABLE question_choices DROP COLUMN position;
+
+-- enum values cannot be dropped, so ordering questions fall back to single choice
+UPDATE questions SET type = 'single_choice' WHERE type = 'ordering';
+
+COMMIT;
\ No newline at end of file
@@ -0,0 +1,17 @@
+BEGIN;
+
+ALTER TYPE question_type ADD VALUE IF NOT EXISTS 'ordering';
+
+ALTER TABLE question_choices ADD COLUMN position SMALLINT NOT NULL DEFAULT 0;
+ALTER TABLE question_choices ADD COLUMN correct_position SMALLINT NOT NULL DEFAULT 0;
+
+-- existing choices keep the order they were created in
+UPDATE question_choices
+SET position = ordered.position
+FROM (
+    SELECT id, ROW_NUMBER() OVER (PARTITION BY question_id ORDER BY created_at, id) AS position
+    FROM question_choices
+) AS ordered
+WHERE ordered.id = question_choices.id;
+
+COMMIT;
\ No newline at end of file
@@ -0,0 +1,5 @@
+BEGIN;
+
+ALTER TABLE questions DROP COLUMN position;
+
+COMMIT;
\ No newline at end of file
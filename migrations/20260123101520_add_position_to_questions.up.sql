@@ -0,0 +1,15 @@
+BEGIN;
+
+ALTER TABLE questions ADD COLUMN position SMALLINT NOT NULL DEFAULT 0;
+
+-- existing questions keep the order they were created in
+UPDATE questions
+SET position = ordered.position
+FROM (
+    SELECT id, ROW_NUMBER() OVER (PARTITION BY module_id ORDER BY created_at, id) AS position
+    FROM questions
+    WHERE deleted_at IS NULL
+) AS ordered
+WHERE ordered.id = questions.id;
+
+COMMIT;
\ No newline at end of file
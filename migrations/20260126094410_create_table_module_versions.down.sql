@@ -0,0 +1,8 @@
+BEGIN;
+
+ALTER TABLE submissions DROP COLUMN module_version;
+ALTER TABLE modules DROP COLUMN published_version;
+
+DROP TABLE IF EXISTS module_versions;
+
+COMMIT;
\ No newline at end of file
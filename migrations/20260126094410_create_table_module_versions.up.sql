@@ -0,0 +1,81 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS module_versions (
+    id UUID PRIMARY KEY,
+    module_id UUID NOT NULL,
+    version INTEGER NOT NULL,
+    questions JSONB NOT NULL DEFAULT '[]',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (module_id) REFERENCES modules(id),
+    UNIQUE (module_id, version)
+);
+
+ALTER TABLE modules ADD COLUMN published_version INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE submissions ADD COLUMN module_version INTEGER NOT NULL DEFAULT 0;
+
+-- modules published before versioning get their current questions frozen as version 1
+INSERT INTO module_versions (id, module_id, version, questions)
+SELECT gen_random_uuid(), m.id, 1, COALESCE((
+    SELECT json_agg(json_build_object(
+        'id', q.id,
+        'type', q.type,
+        'content', q.content,
+        'slug', q.slug,
+        'position', q.position,
+        'case_sensitive', q.case_sensitive,
+        'diacritics_sensitive', q.diacritics_sensitive,
+        'numeric_value', q.numeric_value,
+        'tolerance', q.tolerance,
+        'tolerance_type', q.tolerance_type,
+        'choices', COALESCE((
+            SELECT json_agg(json_build_object(
+                'id', c.id,
+                'content', c.content,
+                'is_correct_answer', c.is_correct_answer,
+                'position', c.position,
+                'correct_position', c.correct_position
+            ) ORDER BY c.position)
+            FROM question_choices c
+            WHERE c.question_id = q.id AND c.deleted_at IS NULL
+        ), '[]'),
+        'pairs', COALESCE((
+            SELECT json_agg(json_build_object(
+                'id', p.id,
+                'left_content', p.left_content,
+                'right_id', p.right_id,
+                'right_content', p.right_content
+            ) ORDER BY p.created_at)
+            FROM question_pairs p
+            WHERE p.question_id = q.id AND p.deleted_at IS NULL
+        ), '[]'),
+        'accepted_answers', COALESCE((
+            SELECT json_agg(json_build_object(
+                'id', a.id,
+                'content', a.content,
+                'is_regex', a.is_regex
+            ) ORDER BY a.created_at)
+            FROM question_accepted_answers a
+            WHERE a.question_id = q.id AND a.deleted_at IS NULL
+        ), '[]'),
+        'units', COALESCE((
+            SELECT json_agg(json_build_object(
+                'id', u.id,
+                'name', u.name
+            ) ORDER BY u.created_at)
+            FROM question_units u
+            WHERE u.question_id = q.id AND u.deleted_at IS NULL
+        ), '[]')
+    ) ORDER BY q.position, q.created_at)
+    FROM questions q
+    WHERE q.module_id = m.id AND q.deleted_at IS NULL
+), '[]')
+FROM modules m
+WHERE m.is_published = TRUE AND m.deleted_at IS NULL;
+
+UPDATE modules SET published_version = 1
+WHERE id IN (SELECT module_id FROM module_versions);
+
+UPDATE submissions SET module_version = 1
+WHERE module_id IN (SELECT module_id FROM module_versions);
+
+COMMIT;
\ No newline at end of file
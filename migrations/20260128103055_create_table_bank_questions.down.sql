@@ -0,0 +1,8 @@
+BEGIN;
+
+ALTER TABLE questions DROP COLUMN bank_question_id;
+
+DROP TABLE IF EXISTS bank_question_tags;
+DROP TABLE IF EXISTS bank_questions;
+
+COMMIT;
\ No newline at end of file
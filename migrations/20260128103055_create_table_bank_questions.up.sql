@@ -0,0 +1,33 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS bank_questions (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL,
+    subject_id UUID NOT NULL,
+    grade_id UUID NOT NULL,
+    type question_type NOT NULL,
+    content VARCHAR(255) NOT NULL,
+    body JSONB NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES users(id),
+    FOREIGN KEY (subject_id) REFERENCES subjects(id),
+    FOREIGN KEY (grade_id) REFERENCES grades(id)
+);
+
+CREATE TABLE IF NOT EXISTS bank_question_tags (
+    id UUID PRIMARY KEY,
+    bank_question_id UUID NOT NULL,
+    name VARCHAR(50) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (bank_question_id) REFERENCES bank_questions(id),
+    UNIQUE (bank_question_id, name)
+);
+
+CREATE INDEX IF NOT EXISTS idx_bank_question_tags_name ON bank_question_tags(name);
+
+-- questions referencing a bank question follow its edits while their module is unpublished
+ALTER TABLE questions ADD COLUMN bank_question_id UUID REFERENCES bank_questions(id);
+
+COMMIT;
\ No newline at end of file
@@ -0,0 +1,12 @@
+BEGIN;
+
+-- turn weighted points back into a fraction of the question
+UPDATE submission_answers SET points = GREATEST(points, 0) / max_points WHERE max_points > 0;
+ALTER TABLE submission_answers ALTER COLUMN points TYPE NUMERIC(5,4);
+
+ALTER TABLE submission_answers DROP COLUMN max_points;
+ALTER TABLE submissions DROP COLUMN max_points;
+ALTER TABLE modules DROP COLUMN wrong_answer_penalty;
+ALTER TABLE questions DROP COLUMN points;
+
+COMMIT;
\ No newline at end of file
@@ -0,0 +1,25 @@
+BEGIN;
+
+ALTER TABLE questions ADD COLUMN points NUMERIC(6,2) NOT NULL DEFAULT 1;
+
+-- fraction of a question's points taken off when it is answered completely wrong
+ALTER TABLE modules ADD COLUMN wrong_answer_penalty NUMERIC(3,2) NOT NULL DEFAULT 0;
+
+ALTER TABLE submissions ADD COLUMN max_points NUMERIC(8,2) NOT NULL DEFAULT 0;
+ALTER TABLE submission_answers ADD COLUMN max_points NUMERIC(6,2) NOT NULL DEFAULT 1;
+
+-- answers now store weighted points, which can exceed one or go negative
+ALTER TABLE submission_answers ALTER COLUMN points TYPE NUMERIC(10,4);
+
+-- every question used to be worth one point
+UPDATE submissions SET max_points = total_questions;
+
+UPDATE module_versions
+SET questions = COALESCE((
+    SELECT jsonb_agg(question || '{"points": 1}'::jsonb)
+    FROM jsonb_array_elements(module_versions.questions) AS question
+), '[]'::jsonb);
+
+UPDATE bank_questions SET body = body || '{"points": 1}'::jsonb;
+
+COMMIT;
\ No newline at end of file
@@ -0,0 +1,5 @@
+BEGIN;
+
+DROP TABLE IF EXISTS question_attachments;
+
+COMMIT;
\ No newline at end of file
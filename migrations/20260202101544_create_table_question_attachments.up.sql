@@ -0,0 +1,20 @@
+BEGIN;
+
+-- files stay in storage after an attachment is removed so published versions keep serving them
+CREATE TABLE IF NOT EXISTS question_attachments (
+    id UUID PRIMARY KEY,
+    question_id UUID NOT NULL,
+    choice_position SMALLINT NOT NULL DEFAULT 0,
+    storage_key VARCHAR(255) NOT NULL,
+    filename VARCHAR(255) NOT NULL,
+    content_type VARCHAR(100) NOT NULL,
+    size BIGINT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP,
+    FOREIGN KEY (question_id) REFERENCES questions(id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_question_attachments_question_id ON question_attachments(question_id);
+
+COMMIT;
\ No newline at end of file
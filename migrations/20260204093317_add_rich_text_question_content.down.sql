@@ -0,0 +1,14 @@
+BEGIN;
+
+-- longer content is cut off to fit the old columns
+ALTER TABLE submission_answers ALTER COLUMN answer TYPE VARCHAR(255) USING LEFT(answer, 255);
+ALTER TABLE submission_answers ALTER COLUMN question TYPE VARCHAR(255) USING LEFT(question, 255);
+ALTER TABLE bank_questions ALTER COLUMN content TYPE VARCHAR(255) USING LEFT(content, 255);
+ALTER TABLE question_choices ALTER COLUMN content TYPE VARCHAR(255) USING LEFT(content, 255);
+ALTER TABLE questions ALTER COLUMN content TYPE VARCHAR(255) USING LEFT(content, 255);
+
+ALTER TABLE questions DROP COLUMN content_format;
+
+DROP TYPE IF EXISTS content_format;
+
+COMMIT;
\ No newline at end of file
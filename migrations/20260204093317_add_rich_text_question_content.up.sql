@@ -0,0 +1,22 @@
+BEGIN;
+
+CREATE TYPE content_format AS ENUM ('plain', 'markdown');
+
+ALTER TABLE questions ADD COLUMN content_format content_format NOT NULL DEFAULT 'plain';
+
+-- markdown and LaTeX content easily outgrows 255 characters
+ALTER TABLE questions ALTER COLUMN content TYPE TEXT;
+ALTER TABLE question_choices ALTER COLUMN content TYPE TEXT;
+ALTER TABLE bank_questions ALTER COLUMN content TYPE TEXT;
+ALTER TABLE submission_answers ALTER COLUMN question TYPE TEXT;
+ALTER TABLE submission_answers ALTER COLUMN answer TYPE TEXT;
+
+UPDATE module_versions
+SET questions = COALESCE((
+    SELECT jsonb_agg(question || '{"content_format": "plain"}'::jsonb)
+    FROM jsonb_array_elements(module_versions.questions) AS question
+), '[]'::jsonb);
+
+UPDATE bank_questions SET body = body || '{"content_format": "plain"}'::jsonb;
+
+COMMIT;
\ No newline at end of file
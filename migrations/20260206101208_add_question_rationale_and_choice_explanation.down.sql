@@ -0,0 +1,6 @@
+BEGIN;
+
+ALTER TABLE question_choices DROP COLUMN explanation;
+ALTER TABLE questions DROP COLUMN rationale;
+
+COMMIT;
\ No newline at end of file
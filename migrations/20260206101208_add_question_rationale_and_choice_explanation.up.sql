@@ -0,0 +1,7 @@
+BEGIN;
+
+-- shown to students once they have answered the question
+ALTER TABLE questions ADD COLUMN rationale TEXT NOT NULL DEFAULT '';
+ALTER TABLE question_choices ADD COLUMN explanation TEXT NOT NULL DEFAULT '';
+
+COMMIT;
\ No newline at end of file
@@ -0,0 +1,6 @@
+BEGIN;
+
+ALTER TABLE submissions DROP COLUMN question_seed;
+ALTER TABLE modules DROP COLUMN shuffle_questions;
+
+COMMIT;
\ No newline at end of file
@@ -0,0 +1,9 @@
+BEGIN;
+
+-- serve every submission the questions in its own order
+ALTER TABLE modules ADD COLUMN shuffle_questions BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- seeds the order a submission is served its questions in, 0 keeps the module order
+ALTER TABLE submissions ADD COLUMN question_seed BIGINT NOT NULL DEFAULT 0;
+
+COMMIT;
\ No newline at end of file
@@ -0,0 +1,5 @@
+BEGIN;
+
+ALTER TABLE modules DROP COLUMN shuffle_choices;
+
+COMMIT;
\ No newline at end of file
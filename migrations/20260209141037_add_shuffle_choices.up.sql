@@ -0,0 +1,6 @@
+BEGIN;
+
+-- serve every submission the choices of each question in its own order
+ALTER TABLE modules ADD COLUMN shuffle_choices BOOLEAN NOT NULL DEFAULT FALSE;
+
+COMMIT;
\ No newline at end of file
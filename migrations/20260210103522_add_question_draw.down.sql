@@ -0,0 +1,8 @@
+BEGIN;
+
+ALTER TABLE submissions DROP COLUMN question_ids;
+ALTER TABLE questions DROP COLUMN tag;
+ALTER TABLE modules DROP COLUMN stratify_draw;
+ALTER TABLE modules DROP COLUMN draw_count;
+
+COMMIT;
\ No newline at end of file
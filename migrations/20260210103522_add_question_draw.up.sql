@@ -0,0 +1,15 @@
+BEGIN;
+
+-- every submission draws this many questions at random, 0 serves them all
+ALTER TABLE modules ADD COLUMN draw_count INTEGER NOT NULL DEFAULT 0;
+
+-- draw from every question tag in proportion to its share of the questions
+ALTER TABLE modules ADD COLUMN stratify_draw BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- groups questions for a stratified draw, such as a topic or a difficulty
+ALTER TABLE questions ADD COLUMN tag VARCHAR(50) NOT NULL DEFAULT '';
+
+-- questions the submission drew, null when it is served every question
+ALTER TABLE submissions ADD COLUMN question_ids JSONB;
+
+COMMIT;
\ No newline at end of file
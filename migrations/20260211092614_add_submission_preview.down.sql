@@ -0,0 +1,5 @@
+BEGIN;
+
+ALTER TABLE submissions DROP COLUMN is_preview;
+
+COMMIT;
\ No newline at end of file
@@ -0,0 +1,6 @@
+BEGIN;
+
+-- a teacher walking through their own module, kept out of listings, counts and analytics
+ALTER TABLE submissions ADD COLUMN is_preview BOOLEAN NOT NULL DEFAULT FALSE;
+
+COMMIT;
\ No newline at end of file
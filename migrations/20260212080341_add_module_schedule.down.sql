@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP INDEX IF EXISTS idx_modules_closes_at;
+ALTER TABLE modules DROP COLUMN closes_at;
+ALTER TABLE modules DROP COLUMN opens_at;
+
+COMMIT;
\ No newline at end of file
@@ -0,0 +1,10 @@
+BEGIN;
+
+-- students can take the published module from opens_at until closes_at, null leaves that end open
+ALTER TABLE modules ADD COLUMN opens_at TIMESTAMPTZ;
+ALTER TABLE modules ADD COLUMN closes_at TIMESTAMPTZ;
+
+-- the worker looks up closed modules to finalize their submissions left in progress
+CREATE INDEX IF NOT EXISTS idx_modules_closes_at ON modules(closes_at) WHERE closes_at IS NOT NULL;
+
+COMMIT;
\ No newline at end of file
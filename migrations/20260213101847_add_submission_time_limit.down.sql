@@ -0,0 +1,19 @@
+BEGIN;
+
+DROP INDEX IF EXISTS idx_submissions_expires_at;
+ALTER TABLE submissions DROP COLUMN expires_at;
+ALTER TABLE modules DROP COLUMN time_limit_minutes;
+
+-- enum values cannot be dropped, expired submissions go back to submitted and the type is rebuilt
+UPDATE submissions SET status = 'submitted' WHERE status = 'expired';
+
+ALTER TYPE submission_status RENAME TO submission_status_old;
+CREATE TYPE submission_status AS ENUM ('inprogress', 'submitted', 'canceled');
+
+ALTER TABLE submissions ALTER COLUMN status DROP DEFAULT;
+ALTER TABLE submissions ALTER COLUMN status TYPE submission_status USING status::text::submission_status;
+ALTER TABLE submissions ALTER COLUMN status SET DEFAULT 'inprogress';
+
+DROP TYPE submission_status_old;
+
+COMMIT;
\ No newline at end of file
@@ -0,0 +1,15 @@
+BEGIN;
+
+-- a submission whose time ran out, finalized on the answers given before its deadline
+ALTER TYPE submission_status ADD VALUE IF NOT EXISTS 'expired';
+
+-- how long every submission runs before it expires, 0 leaves it untimed
+ALTER TABLE modules ADD COLUMN time_limit_minutes INTEGER NOT NULL DEFAULT 0;
+
+-- deadline set when the submission starts, null when the module is untimed
+ALTER TABLE submissions ADD COLUMN expires_at TIMESTAMPTZ;
+
+-- the worker looks up submissions still in progress past their deadline
+CREATE INDEX IF NOT EXISTS idx_submissions_expires_at ON submissions(expires_at) WHERE status = 'inprogress' AND expires_at IS NOT NULL;
+
+COMMIT;
\ No newline at end of file
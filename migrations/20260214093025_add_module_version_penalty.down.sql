@@ -0,0 +1,5 @@
+BEGIN;
+
+ALTER TABLE module_versions DROP COLUMN wrong_answer_penalty;
+
+COMMIT;
\ No newline at end of file
@@ -0,0 +1,12 @@
+BEGIN;
+
+-- the penalty is frozen with every published version, answers are graded with the one of their version
+ALTER TABLE module_versions ADD COLUMN wrong_answer_penalty NUMERIC(3,2) NOT NULL DEFAULT 0;
+
+-- versions published so far take the penalty their module has now
+UPDATE module_versions
+SET wrong_answer_penalty = modules.wrong_answer_penalty
+FROM modules
+WHERE modules.id = module_versions.module_id;
+
+COMMIT;
\ No newline at end of file
@@ -0,0 +1,5 @@
+BEGIN;
+
+ALTER TABLE submissions DROP COLUMN shuffle_choices;
+
+COMMIT;
\ No newline at end of file
@@ -0,0 +1,12 @@
+BEGIN;
+
+-- the choice order is settled when a submission starts, toggling the module setting no longer reorders it
+ALTER TABLE submissions ADD COLUMN shuffle_choices BOOLEAN NOT NULL DEFAULT false;
+
+-- submissions so far followed the module setting, they keep the order it serves now
+UPDATE submissions
+SET shuffle_choices = modules.shuffle_choices
+FROM modules
+WHERE modules.id = submissions.module_id;
+
+COMMIT;
\ No newline at end of file
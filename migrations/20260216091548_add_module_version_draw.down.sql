@@ -0,0 +1,6 @@
+BEGIN;
+
+ALTER TABLE module_versions DROP COLUMN stratify_draw;
+ALTER TABLE module_versions DROP COLUMN draw_count;
+
+COMMIT;
\ No newline at end of file
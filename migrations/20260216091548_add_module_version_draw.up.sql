@@ -0,0 +1,14 @@
+BEGIN;
+
+-- the draw settings are frozen with every published version, a version only ever draws one way
+ALTER TABLE module_versions ADD COLUMN draw_count INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE module_versions ADD COLUMN stratify_draw BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- versions published so far take the draw settings their module has now
+UPDATE module_versions
+SET draw_count = modules.draw_count,
+    stratify_draw = modules.stratify_draw
+FROM modules
+WHERE modules.id = module_versions.module_id;
+
+COMMIT;
\ No newline at end of file
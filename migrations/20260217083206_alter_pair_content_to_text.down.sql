@@ -0,0 +1,9 @@
+BEGIN;
+
+-- longer content is cut off to fit the old columns
+ALTER TABLE submission_answer_pairs ALTER COLUMN right_content TYPE VARCHAR(255) USING LEFT(right_content, 255);
+ALTER TABLE submission_answer_pairs ALTER COLUMN left_content TYPE VARCHAR(255) USING LEFT(left_content, 255);
+ALTER TABLE question_pairs ALTER COLUMN right_content TYPE VARCHAR(255) USING LEFT(right_content, 255);
+ALTER TABLE question_pairs ALTER COLUMN left_content TYPE VARCHAR(255) USING LEFT(left_content, 255);
+
+COMMIT;
\ No newline at end of file
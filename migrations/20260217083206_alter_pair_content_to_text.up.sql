@@ -0,0 +1,9 @@
+BEGIN;
+
+-- matching items hold markdown and LaTeX the same as choices, the rendered length is limited instead
+ALTER TABLE question_pairs ALTER COLUMN left_content TYPE TEXT;
+ALTER TABLE question_pairs ALTER COLUMN right_content TYPE TEXT;
+ALTER TABLE submission_answer_pairs ALTER COLUMN left_content TYPE TEXT;
+ALTER TABLE submission_answer_pairs ALTER COLUMN right_content TYPE TEXT;
+
+COMMIT;
\ No newline at end of file
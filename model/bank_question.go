@@ -0,0 +1,31 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/guregu/null/v6"
+)
+
+type BankQuestion struct {
+	ID        uuid.UUID    `gorm:"primaryKey;column:id"`
+	UserID    uuid.UUID    `gorm:"column:user_id"`
+	SubjectID uuid.UUID    `gorm:"column:subject_id"`
+	GradeID   uuid.UUID    `gorm:"column:grade_id"`
+	Type      QuestionType `gorm:"type:question_type;column:type"`
+	Content   string       `gorm:"column:content"`
+	// Body holds the answers in the same format module versions freeze questions in
+	Body      QuestionSnapshot `gorm:"type:jsonb;column:body"`
+	CreatedAt time.Time        `gorm:"column:created_at"`
+	UpdatedAt time.Time        `gorm:"column:updated_at"`
+	DeletedAt null.Time        `gorm:"nullable;column:deleted_at"`
+
+	Tags []*BankQuestionTag `gorm:"foreignKey:BankQuestionID;references:ID"`
+}
+
+type BankQuestionTag struct {
+	ID             uuid.UUID `gorm:"primaryKey;column:id"`
+	BankQuestionID uuid.UUID `gorm:"column:bank_question_id"`
+	Name           string    `gorm:"column:name"`
+	CreatedAt      time.Time `gorm:"column:created_at"`
+}
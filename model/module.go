@@ -14,19 +14,37 @@ const (
 	MatchingType   ModuleType = "matching_type"
 )
 
+type ScoringPolicy string
+
+const (
+	AllOrNothing    ScoringPolicy = "all_or_nothing"
+	Proportional    ScoringPolicy = "proportional"
+	RightMinusWrong ScoringPolicy = "right_minus_wrong"
+)
+
 type Module struct {
-	ID          uuid.UUID   `gorm:"primaryKey;column:id"`
-	UserID      uuid.UUID   `gorm:"column:user_id"`
-	SubjectID   uuid.UUID   `gorm:"column:subject_id"`
-	GradeID     uuid.UUID   `gorm:"column:grade_id"`
-	Title       string      `gorm:"column:title"`
-	Slug        string      `gorm:"column:slug"`
-	Description null.String `gorm:"nullable;column:description"`
-	Type        ModuleType  `gorm:"type:module_type;column:type"`
-	IsPublished bool        `gorm:"column:is_published"`
-	CreatedAt   time.Time   `gorm:"column:created_at"`
-	UpdatedAt   time.Time   `gorm:"column:updated_at"`
-	DeletedAt   null.Time   `gorm:"nullable;column:deleted_at"`
+	ID                 uuid.UUID     `gorm:"primaryKey;column:id"`
+	UserID             uuid.UUID     `gorm:"column:user_id"`
+	SubjectID          uuid.UUID     `gorm:"column:subject_id"`
+	GradeID            uuid.UUID     `gorm:"column:grade_id"`
+	Title              string        `gorm:"column:title"`
+	Slug               string        `gorm:"column:slug"`
+	Description        null.String   `gorm:"nullable;column:description"`
+	Type               ModuleType    `gorm:"type:module_type;column:type"`
+	ScoringPolicy      ScoringPolicy `gorm:"type:scoring_policy;column:scoring_policy"`
+	IsPublished        bool          `gorm:"column:is_published"`
+	PublishedVersion   int           `gorm:"column:published_version"`
+	WrongAnswerPenalty float64       `gorm:"column:wrong_answer_penalty"`
+	ShuffleQuestions   bool          `gorm:"column:shuffle_questions"`
+	ShuffleChoices     bool          `gorm:"column:shuffle_choices"`
+	DrawCount          int           `gorm:"column:draw_count"`
+	StratifyDraw       bool          `gorm:"column:stratify_draw"`
+	TimeLimitMinutes   int           `gorm:"column:time_limit_minutes"`
+	OpensAt            null.Time     `gorm:"nullable;column:opens_at"`
+	ClosesAt           null.Time     `gorm:"nullable;column:closes_at"`
+	CreatedAt          time.Time     `gorm:"column:created_at"`
+	UpdatedAt          time.Time     `gorm:"column:updated_at"`
+	DeletedAt          null.Time     `gorm:"nullable;column:deleted_at"`
 
 	Subject   *Subject    `gorm:"foreignKey:SubjectID;references:ID"`
 	Grade     *Grade      `gorm:"foreignKey:GradeID;references:ID"`
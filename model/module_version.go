@@ -0,0 +1,132 @@
+package model
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ModuleVersion struct {
+	ID                 uuid.UUID         `gorm:"primaryKey;column:id"`
+	ModuleID           uuid.UUID         `gorm:"column:module_id"`
+	Version            int               `gorm:"column:version"`
+	WrongAnswerPenalty float64           `gorm:"column:wrong_answer_penalty"`
+	DrawCount          int               `gorm:"column:draw_count"`
+	StratifyDraw       bool              `gorm:"column:stratify_draw"`
+	Questions          QuestionSnapshots `gorm:"type:jsonb;column:questions"`
+	CreatedAt          time.Time         `gorm:"column:created_at"`
+}
+
+// QuestionSnapshots is the frozen copy of a module's questions, stored as JSONB
+type QuestionSnapshots []*QuestionSnapshot
+
+func (s QuestionSnapshots) Value() (driver.Value, error) {
+	if s == nil {
+		return "[]", nil
+	}
+
+	b, err := json.Marshal(s)
+	if err != nil {
+		return nil, err
+	}
+
+	return string(b), nil
+}
+
+func (s *QuestionSnapshots) Scan(value any) error {
+	switch v := value.(type) {
+	case []byte:
+		return json.Unmarshal(v, s)
+	case string:
+		return json.Unmarshal([]byte(v), s)
+	case nil:
+		*s = nil
+		return nil
+	default:
+		return errors.New("unsupported type for question snapshots")
+	}
+}
+
+type QuestionSnapshot struct {
+	ID                  string                            `json:"id"`
+	Type                QuestionType                      `json:"type"`
+	Content             string                            `json:"content"`
+	ContentFormat       ContentFormat                     `json:"content_format"`
+	Rationale           string                            `json:"rationale,omitempty"`
+	Tag                 string                            `json:"tag,omitempty"`
+	Slug                string                            `json:"slug"`
+	Position            int                               `json:"position"`
+	Points              float64                           `json:"points"`
+	CaseSensitive       bool                              `json:"case_sensitive"`
+	DiacriticsSensitive bool                              `json:"diacritics_sensitive"`
+	NumericValue        *float64                          `json:"numeric_value"`
+	Tolerance           float64                           `json:"tolerance"`
+	ToleranceType       ToleranceType                     `json:"tolerance_type"`
+	Choices             []*QuestionChoiceSnapshot         `json:"choices"`
+	Pairs               []*QuestionPairSnapshot           `json:"pairs"`
+	AcceptedAnswers     []*QuestionAcceptedAnswerSnapshot `json:"accepted_answers"`
+	Units               []*QuestionUnitSnapshot           `json:"units"`
+	Attachments         []*QuestionAttachmentSnapshot     `json:"attachments"`
+}
+
+func (s QuestionSnapshot) Value() (driver.Value, error) {
+	b, err := json.Marshal(s)
+	if err != nil {
+		return nil, err
+	}
+
+	return string(b), nil
+}
+
+func (s *QuestionSnapshot) Scan(value any) error {
+	switch v := value.(type) {
+	case []byte:
+		return json.Unmarshal(v, s)
+	case string:
+		return json.Unmarshal([]byte(v), s)
+	case nil:
+		*s = QuestionSnapshot{}
+		return nil
+	default:
+		return errors.New("unsupported type for question snapshot")
+	}
+}
+
+type QuestionChoiceSnapshot struct {
+	ID              string `json:"id"`
+	Content         string `json:"content"`
+	IsCorrectAnswer bool   `json:"is_correct_answer"`
+	Position        int    `json:"position"`
+	CorrectPosition int    `json:"correct_position"`
+	Explanation     string `json:"explanation,omitempty"`
+}
+
+type QuestionPairSnapshot struct {
+	ID           string `json:"id"`
+	LeftContent  string `json:"left_content"`
+	RightID      string `json:"right_id"`
+	RightContent string `json:"right_content"`
+}
+
+type QuestionAcceptedAnswerSnapshot struct {
+	ID      string `json:"id"`
+	Content string `json:"content"`
+	IsRegex bool   `json:"is_regex"`
+}
+
+type QuestionUnitSnapshot struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type QuestionAttachmentSnapshot struct {
+	ID             string `json:"id"`
+	ChoicePosition int    `json:"choice_position"`
+	StorageKey     string `json:"storage_key"`
+	Filename       string `json:"filename"`
+	ContentType    string `json:"content_type"`
+	Size           int64  `json:"size"`
+}
@@ -7,14 +7,55 @@ import (
 	"github.com/guregu/null/v6"
 )
 
+type QuestionType string
+
+const (
+	SingleChoice   QuestionType = "single_choice"
+	MultipleSelect QuestionType = "multiple_select"
+	Matching       QuestionType = "matching"
+	ShortAnswer    QuestionType = "short_answer"
+	Numeric        QuestionType = "numeric"
+	Ordering       QuestionType = "ordering"
+)
+
+type ToleranceType string
+
+const (
+	AbsoluteTolerance   ToleranceType = "absolute"
+	PercentageTolerance ToleranceType = "percentage"
+)
+
+type ContentFormat string
+
+const (
+	PlainText ContentFormat = "plain"
+	Markdown  ContentFormat = "markdown"
+)
+
 type Question struct {
-	ID        uuid.UUID `gorm:"primaryKey;column:id"`
-	ModuleID  uuid.UUID `gorm:"column:module_id"`
-	Content   string    `gorm:"column:content"`
-	Slug      string    `gorm:"column:slug"`
-	CreatedAt time.Time `gorm:"column:created_at"`
-	UpdatedAt time.Time `gorm:"column:updated_at"`
-	DeletedAt null.Time `gorm:"nullable;column:deleted_at"`
-
-	Choices []*QuestionChoice `gorm:"foreignKey:QuestionID;references:ID"`
+	ID                  uuid.UUID     `gorm:"primaryKey;column:id"`
+	ModuleID            uuid.UUID     `gorm:"column:module_id"`
+	Type                QuestionType  `gorm:"type:question_type;column:type"`
+	Content             string        `gorm:"column:content"`
+	ContentFormat       ContentFormat `gorm:"type:content_format;column:content_format"`
+	Rationale           string        `gorm:"column:rationale"`
+	Tag                 string        `gorm:"column:tag"`
+	Slug                string        `gorm:"column:slug"`
+	Position            int           `gorm:"column:position"`
+	Points              float64       `gorm:"column:points"`
+	CaseSensitive       bool          `gorm:"column:case_sensitive"`
+	DiacriticsSensitive bool          `gorm:"column:diacritics_sensitive"`
+	NumericValue        null.Float    `gorm:"nullable;column:numeric_value"`
+	Tolerance           float64       `gorm:"column:tolerance"`
+	ToleranceType       ToleranceType `gorm:"type:tolerance_type;column:tolerance_type"`
+	BankQuestionID      null.String   `gorm:"nullable;column:bank_question_id"`
+	CreatedAt           time.Time     `gorm:"column:created_at"`
+	UpdatedAt           time.Time     `gorm:"column:updated_at"`
+	DeletedAt           null.Time     `gorm:"nullable;column:deleted_at"`
+
+	Choices         []*QuestionChoice         `gorm:"foreignKey:QuestionID;references:ID"`
+	Pairs           []*QuestionPair           `gorm:"foreignKey:QuestionID;references:ID"`
+	AcceptedAnswers []*QuestionAcceptedAnswer `gorm:"foreignKey:QuestionID;references:ID"`
+	Units           []*QuestionUnit           `gorm:"foreignKey:QuestionID;references:ID"`
+	Attachments     []*QuestionAttachment     `gorm:"foreignKey:QuestionID;references:ID"`
 }
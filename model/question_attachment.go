@@ -0,0 +1,21 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/guregu/null/v6"
+)
+
+type QuestionAttachment struct {
+	ID             uuid.UUID `gorm:"primaryKey;column:id"`
+	QuestionID     uuid.UUID `gorm:"column:question_id"`
+	ChoicePosition int       `gorm:"column:choice_position"`
+	StorageKey     string    `gorm:"column:storage_key"`
+	Filename       string    `gorm:"column:filename"`
+	ContentType    string    `gorm:"column:content_type"`
+	Size           int64     `gorm:"column:size"`
+	CreatedAt      time.Time `gorm:"column:created_at"`
+	UpdatedAt      time.Time `gorm:"column:updated_at"`
+	DeletedAt      null.Time `gorm:"nullable;column:deleted_at"`
+}
@@ -12,6 +12,9 @@ type QuestionChoice struct {
 	QuestionID      uuid.UUID `gorm:"column:question_id"`
 	Content         string    `gorm:"column:content"`
 	IsCorrectAnswer bool      `gorm:"column:is_correct_answer"`
+	Position        int       `gorm:"column:position"`
+	CorrectPosition int       `gorm:"column:correct_position"`
+	Explanation     string    `gorm:"column:explanation"`
 	CreatedAt       time.Time `gorm:"column:created_at"`
 	UpdatedAt       time.Time `gorm:"column:updated_at"`
 	DeletedAt       null.Time `gorm:"nullable;column:deleted_at"`
@@ -0,0 +1,19 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/guregu/null/v6"
+)
+
+type QuestionPair struct {
+	ID           uuid.UUID `gorm:"primaryKey;column:id"`
+	QuestionID   uuid.UUID `gorm:"column:question_id"`
+	LeftContent  string    `gorm:"column:left_content"`
+	RightID      uuid.UUID `gorm:"column:right_id"`
+	RightContent string    `gorm:"column:right_content"`
+	CreatedAt    time.Time `gorm:"column:created_at"`
+	UpdatedAt    time.Time `gorm:"column:updated_at"`
+	DeletedAt    null.Time `gorm:"nullable;column:deleted_at"`
+}
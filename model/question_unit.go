@@ -0,0 +1,17 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/guregu/null/v6"
+)
+
+type QuestionUnit struct {
+	ID         uuid.UUID `gorm:"primaryKey;column:id"`
+	QuestionID uuid.UUID `gorm:"column:question_id"`
+	Name       string    `gorm:"column:name"`
+	CreatedAt  time.Time `gorm:"column:created_at"`
+	UpdatedAt  time.Time `gorm:"column:updated_at"`
+	DeletedAt  null.Time `gorm:"nullable;column:deleted_at"`
+}
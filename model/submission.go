@@ -1,6 +1,9 @@
 package model
 
 import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
 	"time"
 
 	"github.com/google/uuid"
@@ -13,6 +16,7 @@ const (
 	InProgress SubmissionStatus = "inprogress"
 	Submitted  SubmissionStatus = "submitted"
 	Canceled   SubmissionStatus = "canceled"
+	Expired    SubmissionStatus = "expired"
 )
 
 type Submission struct {
@@ -22,6 +26,13 @@ type Submission struct {
 	StudentName    string           `gorm:"column:student_name"`
 	Status         SubmissionStatus `gorm:"type:submission_status;column:status"`
 	TotalQuestions int              `gorm:"column:total_questions"`
+	ModuleVersion  int              `gorm:"column:module_version"`
+	MaxPoints      float64          `gorm:"column:max_points"`
+	QuestionSeed   int64            `gorm:"column:question_seed"`
+	ShuffleChoices bool             `gorm:"column:shuffle_choices"`
+	QuestionIDs    QuestionIDs      `gorm:"type:jsonb;column:question_ids"`
+	IsPreview      bool             `gorm:"column:is_preview"`
+	ExpiresAt      null.Time        `gorm:"nullable;column:expires_at"`
 	SubmittedAt    null.Time        `gorm:"column:submitted_at"`
 	CreatedAt      time.Time        `gorm:"column:created_at"`
 	UpdatedAt      time.Time        `gorm:"column:updated_at"`
@@ -29,3 +40,33 @@ type Submission struct {
 	Module  *Module             `gorm:"foreignKey:ModuleID;references:ID"`
 	Answers []*SubmissionAnswer `gorm:"foreignKey:SubmissionID;references:ID"`
 }
+
+// QuestionIDs are the questions a submission drew, stored as JSONB. Null when it is served every question.
+type QuestionIDs []string
+
+func (ids QuestionIDs) Value() (driver.Value, error) {
+	if ids == nil {
+		return nil, nil
+	}
+
+	b, err := json.Marshal(ids)
+	if err != nil {
+		return nil, err
+	}
+
+	return string(b), nil
+}
+
+func (ids *QuestionIDs) Scan(value any) error {
+	switch v := value.(type) {
+	case []byte:
+		return json.Unmarshal(v, ids)
+	case string:
+		return json.Unmarshal([]byte(v), ids)
+	case nil:
+		*ids = nil
+		return nil
+	default:
+		return errors.New("unsupported type for question ids")
+	}
+}
@@ -12,7 +12,10 @@ type SubmissionAnswer struct {
 	QuestionSlug string    `gorm:"column:question_slug"`
 	Question     string    `gorm:"column:question"`
 	Answer       string    `gorm:"column:answer"`
-	IsCorrect    bool      `gorm:"column:is_correct"`
+	Points       float64   `gorm:"column:points"`
+	MaxPoints    float64   `gorm:"column:max_points"`
 	CreatedAt    time.Time `gorm:"column:created_at"`
 	UpdatedAt    time.Time `gorm:"column:updated_at"`
+
+	Pairs []*SubmissionAnswerPair `gorm:"foreignKey:SubmissionAnswerID;references:ID"`
 }
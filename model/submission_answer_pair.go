@@ -0,0 +1,17 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type SubmissionAnswerPair struct {
+	ID                 uuid.UUID `gorm:"primaryKey;column:id"`
+	SubmissionAnswerID uuid.UUID `gorm:"column:submission_answer_id"`
+	LeftContent        string    `gorm:"column:left_content"`
+	RightContent       string    `gorm:"column:right_content"`
+	IsCorrect          bool      `gorm:"column:is_correct"`
+	CreatedAt          time.Time `gorm:"column:created_at"`
+	UpdatedAt          time.Time `gorm:"column:updated_at"`
+}